@@ -0,0 +1,330 @@
+package rdbmstool
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+//AlterTableOperationType ALTER TABLE operation type
+type AlterTableOperationType uint8
+
+//ALTER TABLE operation type constants; value follow dependency-safe execution order
+const (
+	//DropForeignKey drop foreign key constraint
+	DropForeignKey AlterTableOperationType = iota + 1
+	//DropIndexKey drop index key
+	DropIndexKey
+	//DropUniqueKey drop unique key constraint
+	DropUniqueKey
+	//DropPrimaryKey drop primary key constraint
+	DropPrimaryKey
+	//AddColumn add new data column
+	AddColumn
+	//ModifyColumn change data column type or nullability
+	ModifyColumn
+	//DropColumn drop data column
+	DropColumn
+	//AddPrimaryKey add primary key constraint
+	AddPrimaryKey
+	//AddUniqueKey add unique key constraint
+	AddUniqueKey
+	//AddIndexKey add index key
+	AddIndexKey
+	//AddForeignKey add foreign key constraint
+	AddForeignKey
+)
+
+//String return operation type in string format
+func (opType AlterTableOperationType) String() string {
+	switch opType {
+	case DropForeignKey:
+		return "DROP FOREIGN KEY"
+	case DropIndexKey:
+		return "DROP INDEX"
+	case DropUniqueKey:
+		return "DROP UNIQUE KEY"
+	case DropPrimaryKey:
+		return "DROP PRIMARY KEY"
+	case AddColumn:
+		return "ADD COLUMN"
+	case ModifyColumn:
+		return "MODIFY COLUMN"
+	case DropColumn:
+		return "DROP COLUMN"
+	case AddPrimaryKey:
+		return "ADD PRIMARY KEY"
+	case AddUniqueKey:
+		return "ADD UNIQUE KEY"
+	case AddIndexKey:
+		return "ADD INDEX"
+	case AddForeignKey:
+		return "ADD FOREIGN KEY"
+	default:
+		return "unknown"
+	}
+}
+
+//AlterTableOperation a single ALTER TABLE operation
+type AlterTableOperation struct {
+	Type AlterTableOperationType
+
+	Column    *ColumnDefinition //AddColumn, ModifyColumn (new definition), DropColumn
+	OldColumn *ColumnDefinition //ModifyColumn (old definition)
+
+	ColumnNames []string //primary key, unique key, or index key columns
+
+	ForeignKeyName string                //resolved foreign key name
+	ForeignKey     *ForeignKeyDefinition //AddForeignKey, DropForeignKey
+}
+
+//AlterTableDefinition ALTER TABLE operations to turn old table definition into new table definition
+type AlterTableDefinition struct {
+	Table      string
+	Operations []AlterTableOperation //sorted in dependency-safe order
+}
+
+//NewAlterTableDefinition compare old and new table definition and
+//generate ALTER TABLE operations to turn old table into new table
+func NewAlterTableDefinition(oldDef *TableDefinition, newDef *TableDefinition) (*AlterTableDefinition, error) {
+	if oldDef == nil || newDef == nil {
+		return nil, errors.New("old and new table definition cannot be null")
+	}
+
+	if strings.Compare(oldDef.Name, newDef.Name) != 0 {
+		return nil, fmt.Errorf("cannot compare different table: %s and %s", oldDef.Name, newDef.Name)
+	}
+
+	if err := oldDef.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid old table definition: %s", err.Error())
+	}
+
+	if err := newDef.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid new table definition: %s", err.Error())
+	}
+
+	alter := &AlterTableDefinition{
+		Table:      newDef.Name,
+		Operations: []AlterTableOperation{}}
+
+	//foreign keys; matched by definition because default name depend on position
+	takenNames := map[string]bool{}
+	for index := range oldDef.ForiegnKeys {
+		fk := &oldDef.ForiegnKeys[index]
+		if findForeignKey(newDef, fk) < 0 {
+			alter.Operations = append(alter.Operations, AlterTableOperation{
+				Type:           DropForeignKey,
+				ForeignKeyName: foreignKeyName(oldDef.Name, index, fk),
+				ForeignKey:     fk})
+		} else {
+			takenNames[foreignKeyName(oldDef.Name, index, fk)] = true
+		}
+	}
+
+	for _, fk := range newDef.ForiegnKeys {
+		takenNames[fk.Name] = true
+	}
+
+	for index := range newDef.ForiegnKeys {
+		fk := &newDef.ForiegnKeys[index]
+		if findForeignKey(oldDef, fk) < 0 {
+			fkName := fk.Name
+			if strings.Compare(fkName, "") == 0 {
+				fkName = nextForeignKeyName(newDef.Name, takenNames)
+				takenNames[fkName] = true
+			}
+
+			alter.Operations = append(alter.Operations, AlterTableOperation{
+				Type:           AddForeignKey,
+				ForeignKeyName: fkName,
+				ForeignKey:     fk})
+		}
+	}
+
+	//index keys
+	for _, ik := range oldDef.Indices {
+		if !hasIndexKey(newDef.Indices, ik.ColumnNames) {
+			alter.Operations = append(alter.Operations, AlterTableOperation{
+				Type: DropIndexKey, ColumnNames: ik.ColumnNames})
+		}
+	}
+
+	for _, ik := range newDef.Indices {
+		if !hasIndexKey(oldDef.Indices, ik.ColumnNames) {
+			alter.Operations = append(alter.Operations, AlterTableOperation{
+				Type: AddIndexKey, ColumnNames: ik.ColumnNames})
+		}
+	}
+
+	//unique keys
+	for _, uk := range oldDef.UniqueKeys {
+		if !hasUniqueKey(newDef.UniqueKeys, uk.ColumnNames) {
+			alter.Operations = append(alter.Operations, AlterTableOperation{
+				Type: DropUniqueKey, ColumnNames: uk.ColumnNames})
+		}
+	}
+
+	for _, uk := range newDef.UniqueKeys {
+		if !hasUniqueKey(oldDef.UniqueKeys, uk.ColumnNames) {
+			alter.Operations = append(alter.Operations, AlterTableOperation{
+				Type: AddUniqueKey, ColumnNames: uk.ColumnNames})
+		}
+	}
+
+	//primary key
+	if !reflect.DeepEqual(normalizeColumnNames(oldDef.PrimaryKey), normalizeColumnNames(newDef.PrimaryKey)) {
+		if len(oldDef.PrimaryKey) > 0 {
+			alter.Operations = append(alter.Operations, AlterTableOperation{
+				Type: DropPrimaryKey, ColumnNames: oldDef.PrimaryKey})
+		}
+
+		if len(newDef.PrimaryKey) > 0 {
+			alter.Operations = append(alter.Operations, AlterTableOperation{
+				Type: AddPrimaryKey, ColumnNames: newDef.PrimaryKey})
+		}
+	}
+
+	//columns
+	for index := range newDef.Columns {
+		newCol := &newDef.Columns[index]
+		oldCol := oldDef.findColumn(newCol.Name)
+
+		if oldCol == nil {
+			alter.Operations = append(alter.Operations, AlterTableOperation{
+				Type: AddColumn, Column: newCol})
+		} else if *oldCol != *newCol {
+			alter.Operations = append(alter.Operations, AlterTableOperation{
+				Type: ModifyColumn, Column: newCol, OldColumn: oldCol})
+		}
+	}
+
+	for index := range oldDef.Columns {
+		if newDef.findColumn(oldDef.Columns[index].Name) == nil {
+			alter.Operations = append(alter.Operations, AlterTableOperation{
+				Type: DropColumn, Column: &oldDef.Columns[index]})
+		}
+	}
+
+	alter.sortOperations()
+
+	return alter, nil
+}
+
+//sortOperations stable sort operations by dependency-safe execution order
+func (alter *AlterTableDefinition) sortOperations() {
+	sorted := make([]AlterTableOperation, 0, len(alter.Operations))
+	for opType := DropForeignKey; opType <= AddForeignKey; opType++ {
+		for _, op := range alter.Operations {
+			if op.Type == opType {
+				sorted = append(sorted, op)
+			}
+		}
+	}
+
+	alter.Operations = sorted
+}
+
+//IsEmpty check old and new table definition is identical
+func (alter *AlterTableDefinition) IsEmpty() bool {
+	return len(alter.Operations) == 0
+}
+
+//SQL generate ALTER TABLE SQL statements with default dialect
+func (alter *AlterTableDefinition) SQL() (string, error) {
+	return alter.SQLDialect(DefaultDialect())
+}
+
+//SQLDialect generate ALTER TABLE SQL statements with specified dialect;
+//every statement is separated by new line
+func (alter *AlterTableDefinition) SQLDialect(dialect Dialect) (string, error) {
+	statements, err := alter.Statements(dialect)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Join(statements, "\n"), nil
+}
+
+//Statements generate ALTER TABLE SQL statements with specified dialect;
+//each statement can be executed separately
+func (alter *AlterTableDefinition) Statements(dialect Dialect) ([]string, error) {
+	dialect = resolveDialect(dialect)
+
+	result := []string{}
+	for index := range alter.Operations {
+		sql, err := dialect.AlterTable(alter.Table, &alter.Operations[index])
+		if err != nil {
+			return nil, fmt.Errorf("Failed to generate %s (index %d) SQL string: %s",
+				alter.Operations[index].Type, index, err.Error())
+		}
+
+		if strings.Compare(sql, "") != 0 {
+			result = append(result, sql)
+		}
+	}
+
+	return result, nil
+}
+
+//nextForeignKeyName generate default foreign key name <table>_ibfk_<n> for added foreign key;
+//n is greater than suffix of any taken default name so that it never collide with kept foreign key
+func nextForeignKeyName(tableName string, takenNames map[string]bool) string {
+	prefix := tableName + "_ibfk_"
+	max := 0
+	for name := range takenNames {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+
+		if n, err := strconv.Atoi(strings.TrimPrefix(name, prefix)); err == nil && n > max {
+			max = n
+		}
+	}
+
+	return fmt.Sprintf("%s%d", prefix, max+1)
+}
+
+//findForeignKey find foreign key with same reference table and columns; return -1 if not found
+func findForeignKey(tableDef *TableDefinition, fk *ForeignKeyDefinition) int {
+	for index, item := range tableDef.ForiegnKeys {
+		if strings.Compare(item.ReferenceTableName, fk.ReferenceTableName) == 0 &&
+			(strings.Compare(fk.Name, "") == 0 || strings.Compare(item.Name, "") == 0 ||
+				strings.Compare(item.Name, fk.Name) == 0) &&
+			reflect.DeepEqual(item.Columns, fk.Columns) {
+			return index
+		}
+	}
+
+	return -1
+}
+
+func hasUniqueKey(keys []UniqueKeyDefinition, columnNames []string) bool {
+	for _, key := range keys {
+		if reflect.DeepEqual(normalizeColumnNames(key.ColumnNames), normalizeColumnNames(columnNames)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasIndexKey(keys []IndexKeyDefinition, columnNames []string) bool {
+	for _, key := range keys {
+		if reflect.DeepEqual(normalizeColumnNames(key.ColumnNames), normalizeColumnNames(columnNames)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+//normalizeColumnNames convert nil column names into empty slice for comparison
+func normalizeColumnNames(columnNames []string) []string {
+	if columnNames == nil {
+		return []string{}
+	}
+
+	return columnNames
+}
@@ -0,0 +1,160 @@
+package rdbmstool
+
+import (
+	"strings"
+	"testing"
+)
+
+func alterTableTestDefinitions() (*TableDefinition, *TableDefinition) {
+	oldDef := NewTableBuilder().
+		TableName("invoice").
+		AddColumnInt("id", 11, false).
+		AddColumnVarchar("code", 20, false).
+		AddColumnVarchar("remark", 100, true).
+		AddColumnInt("customer_id", 11, false).
+		AddPrimaryKey("id").
+		AddUniqueKey("code").
+		AddIndexKey("remark").
+		AddForeignKey("customer_id", "customer", "id").
+		GetTableDefinition()
+
+	newDef := NewTableBuilder().
+		TableName("invoice").
+		AddColumnInt("id", 11, false).
+		AddColumnVarchar("code", 40, false).
+		AddColumnInt("customer_id", 11, false).
+		AddColumnDecimal("amount", 10, 2, true).
+		AddPrimaryKey("id").
+		AddUniqueKey("code").
+		AddIndexKey("customer_id").
+		AddForeignKey("customer_id", "client", "id").
+		GetTableDefinition()
+
+	return oldDef, newDef
+}
+
+func TestAlterTableDefinition_MySQL(t *testing.T) {
+	alter, err := NewAlterTableDefinition(alterTableTestDefinitions())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sql, err := alter.SQLDialect(NewMySQLDialect())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedSQL := "ALTER TABLE `invoice` DROP FOREIGN KEY `invoice_ibfk_1`;\n" +
+		"ALTER TABLE `invoice` DROP INDEX `remark`;\n" +
+		"ALTER TABLE `invoice` ADD COLUMN `amount` decimal(10,2) NULL;\n" +
+		"ALTER TABLE `invoice` MODIFY COLUMN `code` varchar(40) COLLATE utf8mb4_unicode_ci NOT NULL;\n" +
+		"ALTER TABLE `invoice` DROP COLUMN `remark`;\n" +
+		"ALTER TABLE `invoice` ADD KEY `customer_id` (`customer_id`);\n" +
+		"ALTER TABLE `invoice` ADD CONSTRAINT `invoice_ibfk_1` FOREIGN KEY (`customer_id`) REFERENCES `client` (`id`);"
+
+	if strings.Compare(expectedSQL, sql) != 0 {
+		t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expectedSQL, sql)
+	}
+}
+
+func TestAlterTableDefinition_PostgreSQL(t *testing.T) {
+	oldDef, newDef := alterTableTestDefinitions()
+	newDef.PrimaryKey = []string{"code"}
+	newDef.Columns[0].IsNullable = true
+
+	alter, err := NewAlterTableDefinition(oldDef, newDef)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sql, err := alter.SQLDialect(NewPostgreSQLDialect())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedSQL := "ALTER TABLE \"invoice\" DROP CONSTRAINT \"invoice_ibfk_1\";\n" +
+		"DROP INDEX \"invoice_remark_idx\";\n" +
+		"ALTER TABLE \"invoice\" DROP CONSTRAINT \"invoice_pkey\";\n" +
+		"ALTER TABLE \"invoice\" ADD COLUMN \"amount\" numeric(10,2) NULL;\n" +
+		"ALTER TABLE \"invoice\" ALTER COLUMN \"id\" DROP NOT NULL;\n" +
+		"ALTER TABLE \"invoice\" ALTER COLUMN \"code\" TYPE varchar(40);\n" +
+		"ALTER TABLE \"invoice\" DROP COLUMN \"remark\";\n" +
+		"ALTER TABLE \"invoice\" ADD PRIMARY KEY(\"code\");\n" +
+		"CREATE INDEX \"invoice_customer_id_idx\" ON \"invoice\" (\"customer_id\");\n" +
+		"ALTER TABLE \"invoice\" ADD CONSTRAINT \"invoice_ibfk_1\" FOREIGN KEY (\"customer_id\") REFERENCES \"client\" (\"id\");"
+
+	if strings.Compare(expectedSQL, sql) != 0 {
+		t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expectedSQL, sql)
+	}
+
+	if _, err := alter.SQLDialect(NewSQLiteDialect()); err == nil {
+		t.Errorf("expect error since SQLite not support ALTER TABLE DROP CONSTRAINT")
+	}
+
+	same, err := NewAlterTableDefinition(newDef, newDef)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !same.IsEmpty() {
+		t.Errorf("expect no operation on identical table definition but get %d", len(same.Operations))
+	}
+}
+
+func TestAlterTableDefinition_foreignKeyName(t *testing.T) {
+	oldDef := NewTableBuilder().
+		TableName("invoice").
+		AddColumnInt("a_id", 11, false).
+		AddColumnInt("b_id", 11, false).
+		AddColumnInt("c_id", 11, false).
+		AddForeignKey("a_id", "a", "id").
+		AddForeignKey("b_id", "b", "id").
+		GetTableDefinition()
+
+	newDef := NewTableBuilder().
+		TableName("invoice").
+		AddColumnInt("a_id", 11, false).
+		AddColumnInt("b_id", 11, false).
+		AddColumnInt("c_id", 11, false).
+		AddForeignKey("b_id", "b", "id").
+		AddForeignKey("c_id", "c", "id").
+		GetTableDefinition()
+
+	alter, err := NewAlterTableDefinition(oldDef, newDef)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sql, err := alter.SQLDialect(NewMySQLDialect())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedSQL := "ALTER TABLE `invoice` DROP FOREIGN KEY `invoice_ibfk_1`;\n" +
+		"ALTER TABLE `invoice` ADD CONSTRAINT `invoice_ibfk_3` FOREIGN KEY (`c_id`) REFERENCES `c` (`id`);"
+
+	if strings.Compare(expectedSQL, sql) != 0 {
+		t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expectedSQL, sql)
+	}
+
+	//name read from database
+	oldDef.ForiegnKeys[0].Name = "fk_invoice_a"
+	oldDef.ForiegnKeys[1].Name = "invoice_ibfk_7"
+
+	alter, err = NewAlterTableDefinition(oldDef, newDef)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sql, err = alter.SQLDialect(NewMySQLDialect())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedSQL = "ALTER TABLE `invoice` DROP FOREIGN KEY `fk_invoice_a`;\n" +
+		"ALTER TABLE `invoice` ADD CONSTRAINT `invoice_ibfk_8` FOREIGN KEY (`c_id`) REFERENCES `c` (`id`);"
+
+	if strings.Compare(expectedSQL, sql) != 0 {
+		t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expectedSQL, sql)
+	}
+}
@@ -0,0 +1,97 @@
+package rdbmstool
+
+import (
+	"fmt"
+)
+
+//Expression raw SQL expression value which is written into SQL string as it is
+//instead of bound as argument; example: Expression("CURRENT_TIMESTAMP")
+type Expression string
+
+//argBinder collect bound arguments and generate dialect placeholder while generating SQL string
+type argBinder struct {
+	dialect Dialect
+	args    []interface{}
+}
+
+//newArgBinder create new argument binder; fallback to default dialect if dialect is nil
+func newArgBinder(dialect Dialect) *argBinder {
+	return &argBinder{
+		dialect: resolveDialect(dialect),
+		args:    []interface{}{}}
+}
+
+//unboundSQL get generated SQL string which has no bound argument; SQL string with placeholder
+//cannot be executed without its arguments, so caller is pointed to Build
+func unboundSQL(sql string, args []interface{}, err error) (string, error) {
+	if err != nil {
+		return "", err
+	}
+
+	if len(args) > 0 {
+		return "", fmt.Errorf("SQL string has %d bound argument(s), use Build to get SQL string with its arguments", len(args))
+	}
+
+	return sql, nil
+}
+
+//bind register argument value and return its placeholder
+func (binder *argBinder) bind(value interface{}) string {
+	binder.args = append(binder.args, value)
+
+	return binder.dialect.Placeholder(len(binder.args))
+}
+
+//bindValue register argument value and return its placeholder;
+//Expression value is returned as it is without binding
+func (binder *argBinder) bindValue(value interface{}) string {
+	if expr, ok := value.(Expression); ok {
+		return string(expr)
+	}
+
+	return binder.bind(value)
+}
+
+//bindExpression replace every ? placeholder (outside quoted string) with
+//dialect placeholder and register its argument value
+func (binder *argBinder) bindExpression(expression string, args []interface{}) (string, error) {
+	if len(args) == 0 {
+		return expression, nil
+	}
+
+	result := ""
+	argIndex := 0
+	var quote rune
+
+	for _, r := range expression {
+		if quote != 0 {
+			if r == quote {
+				quote = 0
+			}
+			result = result + string(r)
+			continue
+		}
+
+		switch r {
+		case '\'', '"', '`':
+			quote = r
+			result = result + string(r)
+		case '?':
+			if argIndex >= len(args) {
+				return "", fmt.Errorf(
+					"expression (%s) has more placeholders than %d argument(s)", expression, len(args))
+			}
+			result = result + binder.bind(args[argIndex])
+			argIndex++
+		default:
+			result = result + string(r)
+		}
+	}
+
+	if argIndex != len(args) {
+		return "", fmt.Errorf(
+			"expression (%s) has %d placeholder(s) but %d argument(s) given", expression, argIndex, len(args))
+	}
+
+	return result, nil
+}
@@ -1,5 +1,11 @@
 package rdbmstool
 
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
 //ConditionOperator logic operator for WHERE clause: =,<>,>,>=,<,<=, etc.
 type ConditionOperator uint8
 
@@ -27,6 +33,7 @@ func (operator ConditionOperator) String() string {
 //ConditionDefinition SQL condition definition version 2
 type ConditionDefinition struct {
 	Condition        string
+	Args             []interface{} //bound argument for each ? placeholder in Condition
 	ConditionComplex *ConditionDefinition
 	Operator         ConditionOperator
 
@@ -43,13 +50,108 @@ func NewCondition(expression string) *ConditionDefinition {
 	}
 }
 
-//String generate SQL statement
+//NewConditionArgs create a new condition with ? placeholder(s) and its bound argument(s)
+//example: NewConditionArgs("a.age > ? AND a.age < ?", 18, 60)
+func NewConditionArgs(expression string, args ...interface{}) *ConditionDefinition {
+	return &ConditionDefinition{
+		Condition:        expression,
+		Args:             args,
+		ConditionComplex: nil,
+		Operator:         None,
+		Conditions:       []ConditionDefinition{},
+	}
+}
+
+//NewConditionEqual create a new "expression = value" condition
+func NewConditionEqual(expression string, value interface{}) *ConditionDefinition {
+	return NewConditionArgs(expression+" = ?", value)
+}
+
+//NewConditionNotEqual create a new "expression <> value" condition
+func NewConditionNotEqual(expression string, value interface{}) *ConditionDefinition {
+	return NewConditionArgs(expression+" <> ?", value)
+}
+
+//NewConditionGreater create a new "expression > value" condition
+func NewConditionGreater(expression string, value interface{}) *ConditionDefinition {
+	return NewConditionArgs(expression+" > ?", value)
+}
+
+//NewConditionGreaterEqual create a new "expression >= value" condition
+func NewConditionGreaterEqual(expression string, value interface{}) *ConditionDefinition {
+	return NewConditionArgs(expression+" >= ?", value)
+}
+
+//NewConditionLesser create a new "expression < value" condition
+func NewConditionLesser(expression string, value interface{}) *ConditionDefinition {
+	return NewConditionArgs(expression+" < ?", value)
+}
+
+//NewConditionLesserEqual create a new "expression <= value" condition
+func NewConditionLesserEqual(expression string, value interface{}) *ConditionDefinition {
+	return NewConditionArgs(expression+" <= ?", value)
+}
+
+//NewConditionLike create a new "expression LIKE pattern" condition
+func NewConditionLike(expression string, pattern interface{}) *ConditionDefinition {
+	return NewConditionArgs(expression+" LIKE ?", pattern)
+}
+
+//NewConditionIn create a new "expression IN (values...)" condition
+//NOTE: empty values generate "expression IN (NULL)" which never match any row
+func NewConditionIn(expression string, values ...interface{}) *ConditionDefinition {
+	if len(values) == 0 {
+		return NewCondition(expression + " IN (NULL)")
+	}
+
+	placeholders := ""
+	for index := range values {
+		if index == 0 {
+			placeholders = "?"
+		} else {
+			placeholders = placeholders + ", ?"
+		}
+	}
+
+	return NewConditionArgs(expression+" IN ("+placeholders+")", values...)
+}
+
+//NewConditionBetween create a new "expression BETWEEN from AND to" condition
+func NewConditionBetween(expression string, from interface{}, to interface{}) *ConditionDefinition {
+	return NewConditionArgs(expression+" BETWEEN ? AND ?", from, to)
+}
+
+//NewConditionIsNull create a new "expression IS NULL" condition
+func NewConditionIsNull(expression string) *ConditionDefinition {
+	return NewCondition(expression + " IS NULL")
+}
+
+//NewConditionIsNotNull create a new "expression IS NOT NULL" condition
+func NewConditionIsNotNull(expression string) *ConditionDefinition {
+	return NewCondition(expression + " IS NOT NULL")
+}
+
+//String generate SQL statement; bound argument remain as ? placeholder
 func (cond *ConditionDefinition) String() (string, error) {
+	return cond.build(nil)
+}
+
+//build generate SQL statement; bind arguments into dialect placeholders if binder is not nil
+func (cond *ConditionDefinition) build(binder *argBinder) (string, error) {
 	sqlString := ""
 	if cond.IsSimpleExpression() {
 		sqlString = cond.Condition
+
+		if binder != nil {
+			tmpStr, tmpErr := binder.bindExpression(cond.Condition, cond.Args)
+			if tmpErr != nil {
+				return "", tmpErr
+			}
+
+			sqlString = tmpStr
+		}
 	} else {
-		tmpStr, tmpErr := cond.ConditionComplex.String()
+		tmpStr, tmpErr := cond.ConditionComplex.build(binder)
 		if tmpErr != nil {
 			return "", tmpErr
 		}
@@ -62,7 +164,7 @@ func (cond *ConditionDefinition) String() (string, error) {
 	}
 
 	for i := 0; i < len(cond.Conditions); i++ {
-		tmpSQL, tmpErr := cond.Conditions[i].String()
+		tmpSQL, tmpErr := cond.Conditions[i].build(binder)
 		if tmpErr != nil {
 			return "", tmpErr
 		}
@@ -76,6 +178,7 @@ func (cond *ConditionDefinition) String() (string, error) {
 //SetCondition set condition with expression string
 func (cond *ConditionDefinition) SetCondition(condition string) *ConditionDefinition {
 	cond.Condition = condition
+	cond.Args = nil
 	cond.Operator = None
 	cond.ConditionComplex = nil
 	cond.Conditions = nil
@@ -86,6 +189,7 @@ func (cond *ConditionDefinition) SetCondition(condition string) *ConditionDefini
 //SetComplex set condition with ConditionDefinition instance
 func (cond *ConditionDefinition) SetComplex(condDef *ConditionDefinition) *ConditionDefinition {
 	cond.Condition = ""
+	cond.Args = nil
 	cond.Operator = None
 	cond.ConditionComplex = condDef
 	cond.Conditions = nil
@@ -105,6 +209,19 @@ func (cond *ConditionDefinition) AddAnd(expression string) *ConditionDefinition
 	return cond
 }
 
+//AddAndArgs Append AND expression condition with ? placeholder(s) and its bound argument(s)
+func (cond *ConditionDefinition) AddAndArgs(expression string, args ...interface{}) *ConditionDefinition {
+	cond.Conditions = append(cond.Conditions, ConditionDefinition{
+		Condition:        expression,
+		Args:             args,
+		ConditionComplex: nil,
+		Operator:         And,
+		Conditions:       nil,
+	})
+
+	return cond
+}
+
 //AddAndComplex Append AND nested condition
 func (cond *ConditionDefinition) AddAndComplex(condition *ConditionDefinition) *ConditionDefinition {
 	cond.Conditions = append(cond.Conditions, ConditionDefinition{
@@ -129,6 +246,19 @@ func (cond *ConditionDefinition) AddOr(expression string) *ConditionDefinition {
 	return cond
 }
 
+//AddOrArgs Append OR expression condition with ? placeholder(s) and its bound argument(s)
+func (cond *ConditionDefinition) AddOrArgs(expression string, args ...interface{}) *ConditionDefinition {
+	cond.Conditions = append(cond.Conditions, ConditionDefinition{
+		Condition:        expression,
+		Args:             args,
+		ConditionComplex: nil,
+		Operator:         Or,
+		Conditions:       nil,
+	})
+
+	return cond
+}
+
 //AddOrComplex Append AND nested condition
 func (cond *ConditionDefinition) AddOrComplex(condition *ConditionDefinition) *ConditionDefinition {
 	cond.Conditions = append(cond.Conditions, ConditionDefinition{
@@ -168,3 +298,77 @@ func (cond *ConditionDefinition) GetConditions(index int) *ConditionDefinition {
 func (cond *ConditionDefinition) IsSimpleExpression() bool {
 	return cond.ConditionComplex == nil
 }
+
+//isBlankCondition check condition tree has no expression at all, example: nil,
+//&ConditionDefinition{} or NewCondition("  "); it is same as no condition
+func isBlankCondition(cond *ConditionDefinition) bool {
+	if cond == nil {
+		return true
+	}
+
+	if cond.IsSimpleExpression() {
+		if strings.Compare(strings.TrimSpace(cond.Condition), "") != 0 {
+			return false
+		}
+	} else if !isBlankCondition(cond.ConditionComplex) {
+		return false
+	}
+
+	for index := range cond.Conditions {
+		if !isBlankCondition(&cond.Conditions[index]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+//buildWhereClause generate WHERE clause start with new line; joinCondition (if any) is
+//prepended with AND operator; return empty string if both condition are empty
+func buildWhereClause(binder *argBinder, where *ConditionDefinition, joinCondition string) (string, error) {
+	if isBlankCondition(where) {
+		if strings.Compare(joinCondition, "") == 0 {
+			return "", nil
+		}
+
+		return "\nWHERE " + joinCondition, nil
+	}
+
+	whereSQL, whereErr := where.build(binder)
+	if whereErr != nil {
+		return "", errors.New("Failed to generate WHERE SQL string: " + whereErr.Error())
+	}
+
+	if strings.Compare(joinCondition, "") == 0 {
+		return "\nWHERE " + whereSQL, nil
+	}
+
+	return "\nWHERE " + joinCondition + " AND (" + whereSQL + ")", nil
+}
+
+//Validate check condition definition integrity
+func (cond *ConditionDefinition) Validate() error {
+	v := &validator{}
+
+	if cond.IsSimpleExpression() {
+		if strings.Compare(strings.TrimSpace(cond.Condition), "") == 0 {
+			v.add("", "condition expression cannot be empty")
+		} else if _, err := newArgBinder(nil).bindExpression(cond.Condition, cond.Args); err != nil {
+			v.add("", "%s", err.Error())
+		}
+	} else {
+		v.merge("", cond.ConditionComplex.Validate())
+	}
+
+	for index := range cond.Conditions {
+		field := fmt.Sprintf("condition[%d]", index)
+
+		if cond.Conditions[index].Operator != And && cond.Conditions[index].Operator != Or {
+			v.add(field, "condition operator must be AND or OR")
+		}
+
+		v.merge(field, cond.Conditions[index].Validate())
+	}
+
+	return v.result()
+}
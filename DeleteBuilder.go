@@ -0,0 +1,174 @@
+package rdbmstool
+
+import (
+	"strings"
+)
+
+//DeleteBuilder SQL DELETE statement builder
+type DeleteBuilder struct {
+	deleteDefinition *DeleteDefinition
+	dialect          Dialect
+}
+
+//NewDeleteBuilder create new DELETE SQL string builder
+func NewDeleteBuilder() *DeleteBuilder {
+	return &DeleteBuilder{
+		deleteDefinition: &DeleteDefinition{
+			Table:        "",
+			Alias:        "",
+			Join:         nil,
+			Where:        nil,
+			Limit:        0,
+			AllowAllRows: false,
+		},
+		dialect: DefaultDialect()}
+}
+
+//Dialect set SQL dialect used to generate SQL string
+func (builder *DeleteBuilder) Dialect(dialect Dialect) *DeleteBuilder {
+	builder.dialect = dialect
+	return builder
+}
+
+//From set target table name and its alias (optional)
+func (builder *DeleteBuilder) From(tableName string, alias string) *DeleteBuilder {
+	builder.deleteDefinition.Table = tableName
+	builder.deleteDefinition.Alias = alias
+	return builder
+}
+
+//Join set simple Join statement
+func (builder *DeleteBuilder) Join(source string, alias string, joinType JoinType,
+	condition string) *DeleteBuilder {
+
+	builder.deleteDefinition.Join = []JoinDefinition{
+		*NewJoinDefinition(source, alias, joinType, condition)}
+
+	return builder
+}
+
+//JoinAdd append simple Join statement
+func (builder *DeleteBuilder) JoinAdd(source string, alias string, joinType JoinType,
+	condition string) *DeleteBuilder {
+
+	builder.deleteDefinition.Join = append(builder.deleteDefinition.Join,
+		*NewJoinDefinition(source, alias, joinType, condition))
+
+	return builder
+}
+
+//JoinComplexAdd append join statement
+func (builder *DeleteBuilder) JoinComplexAdd(join *JoinDefinition) *DeleteBuilder {
+	builder.deleteDefinition.Join = append(builder.deleteDefinition.Join, *join)
+	return builder
+}
+
+//Where set Where condition with simple expression string
+func (builder *DeleteBuilder) Where(condition string) *DeleteBuilder {
+	if strings.Compare(condition, "") == 0 {
+		builder.deleteDefinition.Where = nil
+	} else if builder.deleteDefinition.Where == nil {
+		builder.deleteDefinition.Where = NewCondition(condition)
+	} else {
+		builder.deleteDefinition.Where.SetCondition(condition)
+	}
+
+	return builder
+}
+
+//WhereAddAnd append AND Where condition with simple expression string
+func (builder *DeleteBuilder) WhereAddAnd(condition string) *DeleteBuilder {
+	return builder.WhereAddAndArgs(condition)
+}
+
+//WhereAddOr append OR Where condition with simple expression string
+func (builder *DeleteBuilder) WhereAddOr(condition string) *DeleteBuilder {
+	return builder.WhereAddOrArgs(condition)
+}
+
+//WhereAddAndArgs append AND Where condition with ? placeholder(s) and its bound argument(s)
+func (builder *DeleteBuilder) WhereAddAndArgs(condition string, args ...interface{}) *DeleteBuilder {
+	if builder.deleteDefinition.Where == nil {
+		builder.deleteDefinition.Where = NewConditionArgs(condition, args...)
+	} else {
+		builder.deleteDefinition.Where.AddAndArgs(condition, args...)
+	}
+
+	return builder
+}
+
+//WhereAddOrArgs append OR Where condition with ? placeholder(s) and its bound argument(s)
+func (builder *DeleteBuilder) WhereAddOrArgs(condition string, args ...interface{}) *DeleteBuilder {
+	if builder.deleteDefinition.Where == nil {
+		builder.deleteDefinition.Where = NewConditionArgs(condition, args...)
+	} else {
+		builder.deleteDefinition.Where.AddOrArgs(condition, args...)
+	}
+
+	return builder
+}
+
+//WhereEqual append AND "expression = value" Where condition
+func (builder *DeleteBuilder) WhereEqual(expression string, value interface{}) *DeleteBuilder {
+	cond := NewConditionEqual(expression, value)
+	return builder.WhereAddAndArgs(cond.Condition, cond.Args...)
+}
+
+//WhereIn append AND "expression IN (values...)" Where condition
+func (builder *DeleteBuilder) WhereIn(expression string, values ...interface{}) *DeleteBuilder {
+	cond := NewConditionIn(expression, values...)
+	return builder.WhereAddAndArgs(cond.Condition, cond.Args...)
+}
+
+//WhereComplex set where condition with ConditionDefinition
+func (builder *DeleteBuilder) WhereComplex(conditionDef *ConditionDefinition) *DeleteBuilder {
+	builder.deleteDefinition.Where = conditionDef
+	return builder
+}
+
+//WhereAddComplex append where condition with ConditionDefinition
+func (builder *DeleteBuilder) WhereAddComplex(operator ConditionOperator,
+	conditionDef *ConditionDefinition) *DeleteBuilder {
+
+	if builder.deleteDefinition.Where == nil {
+		builder.deleteDefinition.Where = conditionDef
+	} else {
+		builder.deleteDefinition.Where.AddComplex(operator, conditionDef)
+	}
+
+	return builder
+}
+
+//WhereClear clear WHERE statement
+func (builder *DeleteBuilder) WhereClear() *DeleteBuilder {
+	builder.deleteDefinition.Where = nil
+	return builder
+}
+
+//Limit set maximum rows to delete (only for dialect support DELETE ... LIMIT); 0 means no limit
+func (builder *DeleteBuilder) Limit(rowCount int) *DeleteBuilder {
+	builder.deleteDefinition.Limit = rowCount
+	return builder
+}
+
+//AllRows explicitly allow DELETE statement without WHERE condition
+func (builder *DeleteBuilder) AllRows(allow bool) *DeleteBuilder {
+	builder.deleteDefinition.AllowAllRows = allow
+	return builder
+}
+
+//Validate check DELETE definition integrity
+func (builder *DeleteBuilder) Validate() error {
+	return builder.deleteDefinition.Validate()
+}
+
+//SQL generate SQL string
+func (builder *DeleteBuilder) SQL() (string, error) {
+	return builder.deleteDefinition.SQLDialect(builder.dialect)
+}
+
+//Build generate SQL string with dialect placeholders and its bound arguments;
+//output is ready to pass into DbHandlerProxy.Exec(sql, args...)
+func (builder *DeleteBuilder) Build() (string, []interface{}, error) {
+	return builder.deleteDefinition.Build(builder.dialect)
+}
@@ -0,0 +1,138 @@
+package rdbmstool
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/guinso/rdbmstool/parser"
+)
+
+func TestDeleteBuilder_Build(t *testing.T) {
+	builder := NewDeleteBuilder().
+		From("session", "").
+		WhereAddAndArgs("expired_on < ?", "2019-01-01").
+		Limit(500)
+
+	sql, args, err := builder.Build()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	expectedSQL := "DELETE FROM session\n" +
+		"WHERE expired_on < ?\n" +
+		"LIMIT 500"
+	if strings.Compare(expectedSQL, sql) != 0 {
+		t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expectedSQL, sql)
+	}
+	if len(args) != 1 || args[0] != "2019-01-01" {
+		t.Errorf("unexpected bound arguments: %v", args)
+	}
+
+	if _, _, err := builder.Dialect(NewPostgreSQLDialect()).Build(); err == nil {
+		t.Errorf("expect error since PostgreSQL not support DELETE with LIMIT")
+	}
+
+	builder.Dialect(NewMySQLDialect()).Limit(0).WhereClear()
+	if err := builder.Validate(); err == nil {
+		t.Errorf("expect validation error since DELETE without WHERE is not explicitly allowed")
+	}
+
+	sql, err = builder.AllRows(true).SQL()
+	if err != nil {
+		t.Error(err)
+	} else if strings.Compare("DELETE FROM session", sql) != 0 {
+		t.Errorf("Expect DELETE FROM session but get:\n%s", sql)
+	}
+}
+
+func TestDeleteBuilder_Join(t *testing.T) {
+	builder := NewDeleteBuilder().
+		From("invoice", "a").
+		Join("customer", "b", InnerJoin, "a.customer_id = b.id").
+		WhereEqual("b.is_closed", true)
+
+	testCases := []struct {
+		dialect     Dialect
+		expectedSQL string
+	}{
+		{NewMySQLDialect(),
+			"DELETE a FROM invoice AS a\n" +
+				"INNER JOIN customer AS b ON a.customer_id = b.id\n" +
+				"WHERE b.is_closed = ?"},
+		{NewPostgreSQLDialect(),
+			"DELETE FROM invoice AS a\n" +
+				"USING customer AS b\n" +
+				"WHERE a.customer_id = b.id AND (b.is_closed = $1)"},
+	}
+
+	for _, testCase := range testCases {
+		sql, _, err := builder.Dialect(testCase.dialect).Build()
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+
+		if strings.Compare(testCase.expectedSQL, sql) != 0 {
+			t.Errorf("%s dialect expect:\n%s\n\nbut get:\n\n%s",
+				testCase.dialect.Name(), testCase.expectedSQL, sql)
+		}
+	}
+
+	if _, _, err := builder.Dialect(NewSQLiteDialect()).Build(); err == nil {
+		t.Errorf("expect error since SQLite not support DELETE with JOIN")
+	}
+}
+
+func TestDeleteBuilder_parse(t *testing.T) {
+	builder := NewDeleteBuilder().
+		From("invoice", "a").
+		Join("customer", "b", InnerJoin, "a.customer_id = b.id").
+		WhereEqual("b.is_closed", true)
+
+	//SQLite not support DELETE with JOIN
+	for _, dialect := range []Dialect{NewMySQLDialect(), NewPostgreSQLDialect(), NewSQLServerDialect()} {
+		sql, _, err := builder.Dialect(dialect).Build()
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+
+		if _, err := parser.ParseSQL(sql); err != nil {
+			t.Errorf("%s dialect fail to parse:\n%s\n\n%s", dialect.Name(), sql, err.Error())
+		}
+	}
+}
+
+func TestDeleteBuilder_blankWhere(t *testing.T) {
+	builders := []*DeleteBuilder{
+		NewDeleteBuilder().From("session", "").Where("  "),
+		NewDeleteBuilder().From("session", "").WhereComplex(&ConditionDefinition{}),
+		NewDeleteBuilder().From("session", "").
+			WhereComplex(NewCondition("").AddOrComplex(NewCondition(" ")))}
+
+	for _, builder := range builders {
+		if _, _, err := builder.Build(); err == nil {
+			t.Errorf("expect error since blank WHERE is same as DELETE without WHERE")
+		}
+
+		sql, _, err := builder.AllRows(true).Build()
+		if err != nil {
+			t.Error(err)
+		} else if strings.Compare("DELETE FROM session", sql) != 0 {
+			t.Errorf("Expect DELETE FROM session but get:\n%s", sql)
+		}
+	}
+}
+
+func TestDeleteBuilder_Validate(t *testing.T) {
+	err := NewDeleteBuilder().
+		From("", "").
+		Limit(-1).
+		Validate()
+
+	expectValidationProblems(t, err, []string{
+		"table: table name cannot be empty",
+		"limit: limit cannot be negative value: -1",
+		"where: DELETE without WHERE condition is refused; explicitly allow all rows if it is intended"})
+}
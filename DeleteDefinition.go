@@ -0,0 +1,187 @@
+package rdbmstool
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+//DeleteDefinition SQL DELETE statement definition
+type DeleteDefinition struct {
+	Table string
+	Alias string
+	Join  []JoinDefinition
+	Where *ConditionDefinition
+	Limit int //maximum rows to delete; 0 means no limit
+
+	//AllowAllRows explicit opt-in to generate DELETE statement without WHERE condition
+	AllowAllRows bool
+}
+
+//SQL generate DELETE SQL string with default dialect
+func (del *DeleteDefinition) SQL() (string, error) {
+	return del.SQLDialect(DefaultDialect())
+}
+
+//SQLDialect generate DELETE SQL string with specified dialect
+func (del *DeleteDefinition) SQLDialect(dialect Dialect) (string, error) {
+	return unboundSQL(del.Build(dialect))
+}
+
+//Build generate DELETE SQL string and its bound arguments with specified dialect
+func (del *DeleteDefinition) Build(dialect Dialect) (string, []interface{}, error) {
+	if err := del.Validate(); err != nil {
+		return "", nil, err
+	}
+
+	binder := newArgBinder(dialect)
+	dialect = binder.dialect
+
+	if del.Limit > 0 {
+		if !dialect.DeleteLimit() {
+			return "", nil, fmt.Errorf("dialect %s not support DELETE with LIMIT", dialect.Name())
+		}
+
+		if len(del.Join) > 0 || strings.Compare(del.Alias, "") != 0 {
+			return "", nil, errors.New("DELETE with JOIN or table alias cannot have LIMIT")
+		}
+	}
+
+	var sql string
+	var err error
+
+	if len(del.Join) == 0 {
+		sql, err = del.buildSimple(binder)
+	} else {
+		switch dialect.DeleteJoin() {
+		case DeleteJoinTarget:
+			sql, err = del.buildJoinTarget(binder)
+		case DeleteJoinUsing:
+			sql, err = del.buildJoinUsing(binder)
+		default:
+			err = fmt.Errorf("dialect %s not support DELETE with JOIN", dialect.Name())
+		}
+	}
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	if del.Limit > 0 {
+		sql = fmt.Sprintf("%s\nLIMIT %d", sql, del.Limit)
+	}
+
+	return sql, binder.args, nil
+}
+
+//Validate check DELETE definition integrity
+func (del *DeleteDefinition) Validate() error {
+	v := &validator{}
+
+	if strings.Compare(del.Table, "") == 0 {
+		v.add("table", "table name cannot be empty")
+	}
+
+	if del.Limit < 0 {
+		v.add("limit", "limit cannot be negative value: %d", del.Limit)
+	}
+
+	for index := range del.Join {
+		v.merge(fmt.Sprintf("join[%d]", index), del.Join[index].Validate())
+	}
+
+	if isBlankCondition(del.Where) {
+		if !del.AllowAllRows {
+			v.add("where", "DELETE without WHERE condition is refused; "+
+				"explicitly allow all rows if it is intended")
+		}
+	} else {
+		v.merge("where", del.Where.Validate())
+	}
+
+	return v.result()
+}
+
+func (del *DeleteDefinition) targetSQL() string {
+	if strings.Compare(del.Alias, "") == 0 {
+		return del.Table
+	}
+
+	return del.Table + " AS " + del.Alias
+}
+
+//DELETE FROM t WHERE ...
+func (del *DeleteDefinition) buildSimple(binder *argBinder) (string, error) {
+	result := "DELETE FROM " + del.targetSQL()
+	if strings.Compare(del.Alias, "") != 0 && binder.dialect.DeleteJoin() == DeleteJoinTarget {
+		result = "DELETE " + del.Alias + " FROM " + del.targetSQL()
+	}
+
+	whereSQL, whereErr := buildWhereClause(binder, del.Where, "")
+	if whereErr != nil {
+		return "", whereErr
+	}
+
+	return result + whereSQL, nil
+}
+
+//DELETE alias FROM t AS alias JOIN x ON ... WHERE ...
+func (del *DeleteDefinition) buildJoinTarget(binder *argBinder) (string, error) {
+	target := del.Table
+	if strings.Compare(del.Alias, "") != 0 {
+		target = del.Alias
+	}
+
+	result := "DELETE " + target + " FROM " + del.targetSQL()
+
+	joinSQL, joinErr := buildJoinList(binder, del.Join)
+	if joinErr != nil {
+		return "", joinErr
+	}
+	result = result + joinSQL
+
+	whereSQL, whereErr := buildWhereClause(binder, del.Where, "")
+	if whereErr != nil {
+		return "", whereErr
+	}
+
+	return result + whereSQL, nil
+}
+
+//DELETE FROM t USING x JOIN y ON ... WHERE <x join condition> AND ...
+func (del *DeleteDefinition) buildJoinUsing(binder *argBinder) (string, error) {
+	first := del.Join[0]
+	if first.Type != Join && first.Type != InnerJoin {
+		return "", fmt.Errorf(
+			"dialect %s require first DELETE join to be inner join", binder.dialect.Name())
+	}
+
+	if first.Where == nil {
+		return "", errors.New("DELETE first join must have join condition")
+	}
+
+	sourceSQL, sourceErr := first.sourceSQL(binder)
+	if sourceErr != nil {
+		return "", fmt.Errorf("Failed to generate DELETE USING SQL string: %s", sourceErr.Error())
+	}
+
+	result := "DELETE FROM " + del.targetSQL() + "\nUSING " + sourceSQL
+
+	joinSQL, joinErr := buildJoinList(binder, del.Join[1:])
+	if joinErr != nil {
+		return "", joinErr
+	}
+	result = result + joinSQL
+
+	onSQL, onErr := first.conditionSQL(binder)
+	if onErr != nil {
+		return "", fmt.Errorf("Failed to generate DELETE USING condition SQL string: %s", onErr.Error())
+	}
+
+	whereSQL, whereErr := buildWhereClause(binder, del.Where, onSQL)
+	if whereErr != nil {
+		return "", whereErr
+	}
+
+	return result + whereSQL, nil
+}
@@ -0,0 +1,119 @@
+package rdbmstool
+
+//Dialect database vendor specific SQL syntax renderer
+//every SQL generator render vendor specific fragment (identifier quoting,
+//data type, pagination, table options, etc.) through dialect
+type Dialect interface {
+	//Name dialect name, example: mysql
+	Name() string
+
+	//QuoteIdentifier quote table, column, or key name
+	QuoteIdentifier(identifier string) string
+
+	//AnsiQuotes check double quoted text is parsed as identifier (ANSI SQL) instead of
+	//string (MySQL)
+	AnsiQuotes() bool
+
+	//ColumnType generate data column type SQL string, example: varchar(100)
+	ColumnType(colDef *ColumnDefinition) (string, error)
+
+	//TableOptions table options append after CREATE TABLE closing parenthesis
+	//return empty string if not applicable
+	TableOptions() string
+
+	//InlinePrimaryKey check primary key is declared on data column itself
+	//instead of PRIMARY KEY clause; example: SQLite INTEGER PRIMARY KEY
+	InlinePrimaryKey(tableDef *TableDefinition) bool
+
+	//UniqueKey generate unique key clause declared inside CREATE TABLE statement
+	UniqueKey(tableName string, columnNames []string) string
+
+	//InlineIndex check index key is declared inside CREATE TABLE statement or
+	//created by separate CREATE INDEX statement
+	InlineIndex() bool
+
+	//IndexKey generate index key clause (inline index) or CREATE INDEX statement
+	IndexKey(tableName string, columnNames []string) string
+
+	//Limit generate pagination SQL string
+	Limit(limit *LimitDefinition) (string, error)
+
+	//ValidateQuery check query definition is supported by dialect
+	ValidateQuery(query *SelectDefinition) error
+
+	//Placeholder generate bound argument placeholder; index start from 1
+	Placeholder(index int) string
+
+	//UpdateJoin syntax to join other table(s) in UPDATE statement
+	UpdateJoin() UpdateJoinStyle
+
+	//DeleteJoin syntax to join other table(s) in DELETE statement
+	DeleteJoin() DeleteJoinStyle
+
+	//DeleteLimit check DELETE ... LIMIT n is supported
+	DeleteLimit() bool
+
+	//Upsert syntax to insert new row or update existing row on key conflict
+	Upsert() UpsertStyle
+
+	//AlterTable generate statement for a single ALTER TABLE operation;
+	//return empty string if operation has no effect on dialect
+	AlterTable(tableName string, operation *AlterTableOperation) (string, error)
+
+	//TransactionalDDL check DDL statement can be rolled back within transaction
+	TransactionalDDL() bool
+
+	//TableExistsQuery query to count data table by name in current database;
+	//table name is bound to first placeholder
+	TableExistsQuery() string
+}
+
+//UpdateJoinStyle syntax to join other table(s) in UPDATE statement
+type UpdateJoinStyle uint8
+
+const (
+	//UpdateJoinInline UPDATE t JOIN x ON ... SET ... WHERE ... (MySQL)
+	UpdateJoinInline UpdateJoinStyle = iota + 1
+	//UpdateJoinFrom UPDATE t SET ... FROM x WHERE <join condition> AND ... (PostgreSQL, SQLite)
+	UpdateJoinFrom
+	//UpdateJoinFromTarget UPDATE alias SET ... FROM t alias JOIN x ON ... WHERE ... (SQL Server)
+	UpdateJoinFromTarget
+)
+
+//DeleteJoinStyle syntax to join other table(s) in DELETE statement
+type DeleteJoinStyle uint8
+
+const (
+	//DeleteJoinNone DELETE statement not support JOIN (SQLite)
+	DeleteJoinNone DeleteJoinStyle = iota + 1
+	//DeleteJoinTarget DELETE alias FROM t AS alias JOIN x ON ... WHERE ... (MySQL, SQL Server)
+	DeleteJoinTarget
+	//DeleteJoinUsing DELETE FROM t USING x WHERE <join condition> AND ... (PostgreSQL)
+	DeleteJoinUsing
+)
+
+//UpsertStyle syntax to insert new row or update existing row on key conflict
+type UpsertStyle uint8
+
+const (
+	//UpsertOnDuplicateKey INSERT ... ON DUPLICATE KEY UPDATE ... (MySQL)
+	UpsertOnDuplicateKey UpsertStyle = iota + 1
+	//UpsertOnConflict INSERT ... ON CONFLICT (...) DO UPDATE SET ... (PostgreSQL, SQLite)
+	UpsertOnConflict
+	//UpsertMerge MERGE INTO ... USING (VALUES ...) ... (SQL Server)
+	UpsertMerge
+)
+
+//DefaultDialect dialect used when no dialect is specified (MySQL)
+func DefaultDialect() Dialect {
+	return NewMySQLDialect()
+}
+
+//resolveDialect fallback to default dialect if input dialect is nil
+func resolveDialect(dialect Dialect) Dialect {
+	if dialect == nil {
+		return DefaultDialect()
+	}
+
+	return dialect
+}
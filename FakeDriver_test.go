@@ -0,0 +1,192 @@
+package rdbmstool
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+//fakeDriver scripted database/sql driver for testing without real database
+type fakeDriver struct{}
+
+//fakeResult query result returned by fake database
+type fakeResult struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+//fakeDB record executed statements and answer query with scripted result
+type fakeDB struct {
+	mu        sync.Mutex
+	execs     []string
+	commits   int
+	rollbacks int
+
+	//query return scripted result; return nil result for empty result set
+	query func(query string, args []driver.Value) (*fakeResult, error)
+	//exec return error to simulate failed statement
+	exec func(query string, args []driver.Value) error
+}
+
+var (
+	fakeDBLock  sync.Mutex
+	fakeDBs     = map[string]*fakeDB{}
+	fakeDBCount = 0
+)
+
+func init() {
+	sql.Register("rdbmstool_fake", &fakeDriver{})
+}
+
+//newFakeDB open sql.DB backed by new fake database
+func newFakeDB() (*sql.DB, *fakeDB) {
+	fakeDBLock.Lock()
+	fakeDBCount++
+	name := fmt.Sprintf("fake%d", fakeDBCount)
+	fake := &fakeDB{}
+	fakeDBs[name] = fake
+	fakeDBLock.Unlock()
+
+	db, _ := sql.Open("rdbmstool_fake", name)
+
+	return db, fake
+}
+
+//newFakeResult create fake result with specified columns and rows
+func newFakeResult(columns []string, values ...[]driver.Value) *fakeResult {
+	return &fakeResult{columns: columns, rows: values}
+}
+
+func (d *fakeDriver) Open(name string) (driver.Conn, error) {
+	fakeDBLock.Lock()
+	defer fakeDBLock.Unlock()
+
+	fake, ok := fakeDBs[name]
+	if !ok {
+		return nil, fmt.Errorf("fake database %s not found", name)
+	}
+
+	return &fakeConn{db: fake}, nil
+}
+
+type fakeConn struct {
+	db *fakeDB
+}
+
+func (conn *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{db: conn.db, query: query}, nil
+}
+
+func (conn *fakeConn) Close() error {
+	return nil
+}
+
+func (conn *fakeConn) Begin() (driver.Tx, error) {
+	return &fakeTx{db: conn.db}, nil
+}
+
+type fakeTx struct {
+	db *fakeDB
+}
+
+func (tx *fakeTx) Commit() error {
+	tx.db.mu.Lock()
+	tx.db.commits++
+	tx.db.mu.Unlock()
+
+	return nil
+}
+
+func (tx *fakeTx) Rollback() error {
+	tx.db.mu.Lock()
+	tx.db.rollbacks++
+	tx.db.mu.Unlock()
+
+	return nil
+}
+
+type fakeStmt struct {
+	db    *fakeDB
+	query string
+}
+
+func (stmt *fakeStmt) Close() error {
+	return nil
+}
+
+func (stmt *fakeStmt) NumInput() int {
+	return -1
+}
+
+func (stmt *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	stmt.db.mu.Lock()
+	stmt.db.execs = append(stmt.db.execs, stmt.query)
+	stmt.db.mu.Unlock()
+
+	if stmt.db.exec != nil {
+		if err := stmt.db.exec(stmt.query, args); err != nil {
+			return nil, err
+		}
+	}
+
+	return driver.RowsAffected(1), nil
+}
+
+func (stmt *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	result := &fakeResult{}
+
+	if stmt.db.query != nil {
+		tmp, err := stmt.db.query(stmt.query, args)
+		if err != nil {
+			return nil, err
+		}
+
+		if tmp != nil {
+			result = tmp
+		}
+	}
+
+	return &fakeRows{result: result}, nil
+}
+
+type fakeRows struct {
+	result *fakeResult
+	index  int
+}
+
+func (r *fakeRows) Columns() []string {
+	if len(r.result.columns) == 0 {
+		return []string{"result"}
+	}
+
+	return r.result.columns
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.index >= len(r.result.rows) {
+		return io.EOF
+	}
+
+	copy(dest, r.result.rows[r.index])
+	r.index++
+
+	return nil
+}
+
+//containsAll check query contain every specified keyword
+func containsAll(query string, keywords ...string) bool {
+	for _, keyword := range keywords {
+		if !strings.Contains(query, keyword) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -14,12 +14,24 @@ type FromDefinition struct {
 	alias string
 }
 
-//SQL generate SQL string for FROM statement
+//SQL generate SQL string for FROM statement with default dialect
 func (from *FromDefinition) SQL() (string, error) {
+	return from.SQLDialect(DefaultDialect())
+}
+
+//SQLDialect generate SQL string for FROM statement with specified dialect
+func (from *FromDefinition) SQLDialect(dialect Dialect) (string, error) {
+	binder := newArgBinder(dialect)
+	sql, err := from.build(binder)
+
+	return unboundSQL(sql, binder.args, err)
+}
+
+func (from *FromDefinition) build(binder *argBinder) (string, error) {
 	result := "FROM "
 
 	if from.queryBuilder != nil {
-		sql, err := from.queryBuilder.SQL()
+		sql, err := from.queryBuilder.build(binder)
 		if err != nil {
 			return "", err
 		}
@@ -55,3 +67,19 @@ func NewFromDefinitionSubQuery(subQuery *SelectDefinition, aliasName string) *Fr
 		queryBuilder: subQuery,
 		alias:        aliasName}
 }
+
+//Validate check FROM definition integrity
+func (from *FromDefinition) Validate() error {
+	v := &validator{}
+
+	if from.queryBuilder != nil {
+		if strings.Compare(from.alias, "") == 0 {
+			v.add("", "sub-query source must have alias")
+		}
+		v.merge("subquery", from.queryBuilder.Validate())
+	} else if strings.Compare(from.expression, "") == 0 {
+		v.add("", "FROM source cannot be empty")
+	}
+
+	return v.result()
+}
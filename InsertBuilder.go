@@ -0,0 +1,106 @@
+package rdbmstool
+
+//InsertBuilder SQL INSERT statement builder
+type InsertBuilder struct {
+	insertDefinition *InsertDefinition
+	dialect          Dialect
+	err              error //invalid builder argument, reported by Validate, SQL and Build
+}
+
+//NewInsertBuilder create new INSERT SQL string builder
+func NewInsertBuilder() *InsertBuilder {
+	return &InsertBuilder{
+		insertDefinition: &InsertDefinition{
+			Table:   "",
+			Columns: nil,
+			Values:  nil,
+			Query:   nil,
+			Schema:  nil,
+		},
+		dialect: DefaultDialect()}
+}
+
+//Dialect set SQL dialect used to generate SQL string
+func (builder *InsertBuilder) Dialect(dialect Dialect) *InsertBuilder {
+	builder.dialect = dialect
+	return builder
+}
+
+//Into set target table name
+func (builder *InsertBuilder) Into(tableName string) *InsertBuilder {
+	builder.insertDefinition.Table = tableName
+	return builder
+}
+
+//Columns set column names
+func (builder *InsertBuilder) Columns(columnNames ...string) *InsertBuilder {
+	builder.insertDefinition.Columns = columnNames
+	return builder
+}
+
+//Values append a value row; use Expression type for raw SQL expression value
+func (builder *InsertBuilder) Values(values ...interface{}) *InsertBuilder {
+	builder.insertDefinition.Values = append(builder.insertDefinition.Values, values)
+	return builder
+}
+
+//ValuesClear clear all value rows
+func (builder *InsertBuilder) ValuesClear() *InsertBuilder {
+	builder.insertDefinition.Values = nil
+	return builder
+}
+
+//Select set INSERT INTO ... SELECT source from query builder
+func (builder *InsertBuilder) Select(query *QueryBuilder) *InsertBuilder {
+	if query == nil {
+		v := &validator{}
+		v.add("query", "SELECT source query builder cannot be nil")
+
+		builder.insertDefinition.Query = nil
+		builder.err = v.result()
+		return builder
+	}
+
+	return builder.SelectComplex(query.selectDefinition)
+}
+
+//SelectComplex set INSERT INTO ... SELECT source from select definition; nil remove SELECT source
+func (builder *InsertBuilder) SelectComplex(query *SelectDefinition) *InsertBuilder {
+	builder.insertDefinition.Query = query
+	builder.err = nil
+	return builder
+}
+
+//Schema set table definition to verify column names
+func (builder *InsertBuilder) Schema(tableDef *TableDefinition) *InsertBuilder {
+	builder.insertDefinition.Schema = tableDef
+	return builder
+}
+
+//Validate check INSERT definition integrity
+func (builder *InsertBuilder) Validate() error {
+	if builder.err != nil {
+		return builder.err
+	}
+
+	return builder.insertDefinition.Validate()
+}
+
+//SQL generate SQL string
+func (builder *InsertBuilder) SQL() (string, error) {
+	if builder.err != nil {
+		return "", builder.err
+	}
+
+	return builder.insertDefinition.SQLDialect(builder.dialect)
+}
+
+//Build generate SQL string with dialect placeholders and its bound arguments;
+//output is ready to pass into DbHandlerProxy.Exec(sql, args...)
+func (builder *InsertBuilder) Build() (string, []interface{}, error) {
+	if builder.err != nil {
+		return "", nil, builder.err
+	}
+
+	return builder.insertDefinition.Build(builder.dialect)
+}
@@ -0,0 +1,132 @@
+package rdbmstool
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestInsertBuilder_Build(t *testing.T) {
+	schema := NewTableBuilder().
+		TableName("member").
+		AddColumnInt("id", 10, false).
+		AddColumnVarchar("name", 100, false).
+		AddColumnDateTime("join_on", false).
+		AddPrimaryKey("id").
+		GetTableDefinition()
+
+	builder := NewInsertBuilder().
+		Dialect(NewPostgreSQLDialect()).
+		Into("member").
+		Columns("id", "name", "join_on").
+		Values(1, "john", Expression("CURRENT_TIMESTAMP")).
+		Values(2, "jane", Expression("CURRENT_TIMESTAMP")).
+		Schema(schema)
+
+	sql, args, err := builder.Build()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	expectedSQL := "INSERT INTO member (id, name, join_on)\n" +
+		"VALUES ($1, $2, CURRENT_TIMESTAMP), ($3, $4, CURRENT_TIMESTAMP)"
+	if strings.Compare(expectedSQL, sql) != 0 {
+		t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expectedSQL, sql)
+	}
+	if len(args) != 4 || args[0] != 1 || args[1] != "john" || args[2] != 2 || args[3] != "jane" {
+		t.Errorf("unexpected bound arguments: %v", args)
+	}
+
+	if _, _, err := builder.Columns("id", "nickname", "join_on").Build(); err == nil {
+		t.Errorf("expect error since column nickname is not defined in table definition")
+	}
+
+	if _, _, err := builder.Columns("id", "name").Build(); err == nil {
+		t.Errorf("expect error since value row quantity not match with column quantity")
+	}
+}
+
+func TestInsertBuilder_Select(t *testing.T) {
+	query := NewQueryBuilder().
+		Select("a.id", "").
+		Select("a.name", "").
+		From("applicant", "a").
+		WhereEqual("a.status", "approved")
+
+	sql, args, err := NewInsertBuilder().
+		Into("member").
+		Columns("id", "name").
+		Select(query).
+		Build()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	expectedSQL := "INSERT INTO member (id, name)\n" +
+		"SELECT a.id, a.name\n" +
+		"FROM applicant AS a\n" +
+		"WHERE a.status = ?"
+	if strings.Compare(expectedSQL, sql) != 0 {
+		t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expectedSQL, sql)
+	}
+	if len(args) != 1 || args[0] != "approved" {
+		t.Errorf("unexpected bound arguments: %v", args)
+	}
+}
+
+func TestInsertBuilder_SelectNil(t *testing.T) {
+	builder := NewInsertBuilder().
+		Into("member").
+		Columns("id", "name").
+		Select(nil)
+
+	if err := builder.Validate(); err == nil {
+		t.Errorf("expect validate error since SELECT source is nil")
+	}
+
+	if _, _, err := builder.Build(); err == nil {
+		t.Errorf("expect build error since SELECT source is nil")
+	}
+
+	if _, err := builder.SQL(); err == nil {
+		t.Errorf("expect SQL error since SELECT source is nil")
+	}
+
+	query := NewQueryBuilder().
+		Select("a.id", "").
+		Select("a.name", "").
+		From("applicant", "a")
+
+	if _, _, err := builder.Select(query).Build(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInsertBuilder_Validate(t *testing.T) {
+	tableDef := NewTableBuilder().
+		TableName("member").
+		AddColumnInt("id", 11, false).
+		GetTableDefinition()
+
+	err := NewInsertBuilder().
+		Into("member").
+		Columns("id", "name").
+		Values(1).
+		Values(2, "john").
+		Schema(tableDef).
+		Validate()
+
+	expectValidationProblems(t, err, []string{
+		"values[0]: has 1 value(s) but 2 column(s) defined",
+		"column[1](name): column not found in table definition (member)"})
+
+	err = NewInsertBuilder().
+		Columns("id").
+		Select(NewQueryBuilder().Select("a.id", "")).
+		Validate()
+
+	expectValidationProblems(t, err, []string{
+		"table: table name cannot be empty",
+		"query.from: query must have FROM source"})
+}
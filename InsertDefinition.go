@@ -0,0 +1,128 @@
+package rdbmstool
+
+import (
+	"fmt"
+	"strings"
+)
+
+//InsertDefinition SQL INSERT statement definition
+type InsertDefinition struct {
+	Table   string
+	Columns []string
+	Values  [][]interface{} //one or more value rows
+	//OR
+	Query *SelectDefinition //INSERT INTO ... SELECT source
+
+	Schema *TableDefinition //optional, used to verify column names
+}
+
+//SQL generate INSERT SQL string with default dialect
+func (insert *InsertDefinition) SQL() (string, error) {
+	return insert.SQLDialect(DefaultDialect())
+}
+
+//SQLDialect generate INSERT SQL string with specified dialect
+func (insert *InsertDefinition) SQLDialect(dialect Dialect) (string, error) {
+	return unboundSQL(insert.Build(dialect))
+}
+
+//Build generate INSERT SQL string and its bound arguments with specified dialect
+func (insert *InsertDefinition) Build(dialect Dialect) (string, []interface{}, error) {
+	if err := insert.Validate(); err != nil {
+		return "", nil, err
+	}
+
+	binder := newArgBinder(dialect)
+
+	result := "INSERT INTO " + insert.Table
+	if len(insert.Columns) > 0 {
+		result = result + " (" + strings.Join(insert.Columns, ", ") + ")"
+	}
+
+	if insert.Query != nil {
+		querySQL, queryErr := insert.Query.build(binder)
+		if queryErr != nil {
+			return "", nil, fmt.Errorf("Failed to generate INSERT SELECT SQL string: %s", queryErr.Error())
+		}
+
+		return result + "\n" + querySQL, binder.args, nil
+	}
+
+	return result + "\nVALUES " + buildValueRows(binder, insert.Values), binder.args, nil
+}
+
+//Validate check INSERT definition integrity
+func (insert *InsertDefinition) Validate() error {
+	v := &validator{}
+
+	if strings.Compare(insert.Table, "") == 0 {
+		v.add("table", "table name cannot be empty")
+	}
+
+	if insert.Query != nil && len(insert.Values) > 0 {
+		v.add("values", "cannot have both value rows and SELECT source")
+	}
+
+	if insert.Query == nil {
+		if len(insert.Columns) == 0 {
+			v.add("columns", "must atleast have one column")
+		}
+
+		if len(insert.Values) == 0 {
+			v.add("values", "must atleast have one value row or SELECT source")
+		}
+
+		for index, row := range insert.Values {
+			if len(row) != len(insert.Columns) {
+				v.add(fmt.Sprintf("values[%d]", index), "has %d value(s) but %d column(s) defined",
+					len(row), len(insert.Columns))
+			}
+		}
+	} else {
+		if len(insert.Columns) > 0 && len(insert.Query.Select) != len(insert.Columns) {
+			v.add("query", "SELECT source has %d column(s) but %d column(s) defined",
+				len(insert.Query.Select), len(insert.Columns))
+		}
+
+		v.merge("query", insert.Query.Validate())
+	}
+
+	if insert.Schema != nil {
+		if strings.Compare(insert.Schema.Name, insert.Table) != 0 {
+			v.add("table", "table (%s) not match with table definition (%s)",
+				insert.Table, insert.Schema.Name)
+		}
+
+		for index, colName := range insert.Columns {
+			if insert.Schema.findColumn(colName) == nil {
+				v.add(fmt.Sprintf("column[%d](%s)", index, colName),
+					"column not found in table definition (%s)", insert.Schema.Name)
+			}
+		}
+	}
+
+	return v.result()
+}
+
+//buildValueRows generate comma separated value rows, example: (?, ?), (?, ?)
+func buildValueRows(binder *argBinder, rows [][]interface{}) string {
+	result := ""
+	for rowIndex, row := range rows {
+		rowSQL := ""
+		for index, value := range row {
+			if index == 0 {
+				rowSQL = binder.bindValue(value)
+			} else {
+				rowSQL = rowSQL + ", " + binder.bindValue(value)
+			}
+		}
+
+		if rowIndex == 0 {
+			result = "(" + rowSQL + ")"
+		} else {
+			result = result + ", (" + rowSQL + ")"
+		}
+	}
+
+	return result
+}
@@ -3,6 +3,7 @@ package rdbmstool
 import (
 	"errors"
 	"fmt"
+	"strings"
 )
 
 //JoinType type for JOIN clause: join, inner join, outer join, cross join, etc.
@@ -32,8 +33,20 @@ type JoinDefinition struct {
 	Where *ConditionDefinition
 }
 
-//SQL generate SQL string for Join link definition
+//SQL generate SQL string for Join link definition with default dialect
 func (join *JoinDefinition) SQL() (string, error) {
+	return join.SQLDialect(DefaultDialect())
+}
+
+//SQLDialect generate SQL string for Join link definition with specified dialect
+func (join *JoinDefinition) SQLDialect(dialect Dialect) (string, error) {
+	binder := newArgBinder(dialect)
+	sql, err := join.build(binder)
+
+	return unboundSQL(sql, binder.args, err)
+}
+
+func (join *JoinDefinition) build(binder *argBinder) (string, error) {
 	result := ""
 
 	switch join.Type {
@@ -50,20 +63,43 @@ func (join *JoinDefinition) SQL() (string, error) {
 		result = result + "LEFT JOIN"
 		break
 	case RightJoin:
-
+		result = result + "RIGHT JOIN"
+		break
 	default:
 		return "", fmt.Errorf("Unsupported JOIN type found: %d", join.Type)
 	}
 
+	sourceSQL, sourceErr := join.sourceSQL(binder)
+	if sourceErr != nil {
+		return "", sourceErr
+	}
+	result = result + " " + sourceSQL
+
+	if join.Where != nil {
+		conditionSQL, sqlErr := join.Where.build(binder)
+		if sqlErr != nil {
+			return "", fmt.Errorf("Unable to generate JOIN condition SQL string: %s", sqlErr.Error())
+		}
+
+		result = result + " ON " + conditionSQL
+	}
+
+	return result, nil
+}
+
+//sourceSQL generate join source SQL string with alias (without JOIN keyword and condition)
+func (join *JoinDefinition) sourceSQL(binder *argBinder) (string, error) {
+	result := ""
+
 	if len(join.source) > 0 {
-		result = result + " " + join.source
+		result = join.source
 	} else if join.subQuery != nil {
-		sql, err := join.subQuery.SQL()
+		sql, err := join.subQuery.build(binder)
 		if err != nil {
 			return "", err
 		}
 
-		result = result + " " + sql
+		result = "(" + sql + ")"
 	} else {
 		return "", errors.New("JoinDefinition source field and subQuery field cannot be NULL")
 	}
@@ -72,13 +108,37 @@ func (join *JoinDefinition) SQL() (string, error) {
 		result = result + " AS " + join.Alias
 	}
 
-	if join.Where != nil {
-		conditionSQL, sqlErr := join.Where.String()
-		if sqlErr != nil {
-			return "", fmt.Errorf("Unable to generate JOIN condition SQL string: %s", sqlErr.Error())
-		}
+	return result, nil
+}
 
-		result = result + " ON " + conditionSQL
+//conditionSQL generate join condition SQL string to be merged into WHERE clause;
+//condition is wrapped with parenthesis if it has more than one expression
+func (join *JoinDefinition) conditionSQL(binder *argBinder) (string, error) {
+	if join.Where == nil {
+		return "", errors.New("JoinDefinition condition cannot be NULL")
+	}
+
+	sql, err := join.Where.build(binder)
+	if err != nil {
+		return "", err
+	}
+
+	if len(join.Where.Conditions) > 0 {
+		return "(" + sql + ")", nil
+	}
+
+	return sql, nil
+}
+
+//buildJoinList generate SQL string for multiple join definitions, each join start with new line
+func buildJoinList(binder *argBinder, joins []JoinDefinition) (string, error) {
+	result := ""
+	for index, join := range joins {
+		joinSQL, joinErr := join.build(binder)
+		if joinErr != nil {
+			return "", fmt.Errorf("Failed to generate JOIN (index %d) SQL string: %s", index, joinErr.Error())
+		}
+		result = result + "\n" + joinSQL
 	}
 
 	return result, nil
@@ -105,3 +165,29 @@ func NewJoinDefinitionComplex(source *SelectDefinition, alias string,
 		Type:     category,
 		Where:    condition}
 }
+
+//Validate check join definition integrity
+func (join *JoinDefinition) Validate() error {
+	v := &validator{}
+
+	if join.Type < Join || join.Type > RightJoin {
+		v.add("type", "unsupported JOIN type: %d", join.Type)
+	}
+
+	if join.subQuery != nil {
+		if strings.Compare(join.Alias, "") == 0 {
+			v.add("alias", "sub-query source must have alias")
+		}
+		v.merge("subquery", join.subQuery.Validate())
+	} else if strings.Compare(join.source, "") == 0 {
+		v.add("source", "JOIN source cannot be empty")
+	}
+
+	if join.Where == nil {
+		v.add("on", "JOIN condition cannot be empty")
+	} else {
+		v.merge("on", join.Where.Validate())
+	}
+
+	return v.result()
+}
@@ -1,18 +1,19 @@
 package rdbmstool
 
-import (
-	"fmt"
-)
-
 //LimitDefinition SQL LIMIT statement definition
 type LimitDefinition struct {
 	RowCount int
 	Offset   int
 }
 
-//SQL generate LIMIT statement SQL string
+//SQL generate LIMIT statement SQL string with default dialect
 func (limit *LimitDefinition) SQL() (string, error) {
-	return fmt.Sprintf("LIMIT %d OFFSET %d", limit.RowCount, limit.Offset), nil
+	return limit.SQLDialect(DefaultDialect())
+}
+
+//SQLDialect generate pagination SQL string with specified dialect
+func (limit *LimitDefinition) SQLDialect(dialect Dialect) (string, error) {
+	return resolveDialect(dialect).Limit(limit)
 }
 
 //NewLimitDefinition create new LIMIT SQL statement definition
@@ -1,5 +1,10 @@
 package rdbmstool
 
+import (
+	"fmt"
+	"strings"
+)
+
 //MetaQuery interface to query datatable's meta data
 type MetaQuery interface {
 	/******** Table *************/
@@ -31,3 +36,111 @@ type MetaQuery interface {
 	//string: view name (example 'tax_invoice')
 	GetViewDefinition(DbHandlerProxy, string, string) (*ViewDefinition, error)
 }
+
+//queryStrings execute query and collect first column of every row as string
+func queryStrings(db DbHandlerProxy, query string, args ...interface{}) ([]string, error) {
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []string{}
+	for rows.Next() {
+		var value string
+		if err := rows.Scan(&value); err != nil {
+			return nil, err
+		}
+
+		result = append(result, value)
+	}
+
+	return result, rows.Err()
+}
+
+//metaKey primary key, unique key, or index key read from database catalog
+type metaKey struct {
+	name        string
+	isPrimary   bool
+	isUnique    bool
+	columnNames []string
+}
+
+//metaKeyCollector collect key columns row by row while keeping key order
+type metaKeyCollector struct {
+	keys []*metaKey
+}
+
+//add append column into named key; key is created if not exists
+func (collector *metaKeyCollector) add(keyName string, isPrimary bool, isUnique bool, columnName string) {
+	for _, key := range collector.keys {
+		if strings.Compare(key.name, keyName) == 0 {
+			key.columnNames = append(key.columnNames, columnName)
+			return
+		}
+	}
+
+	collector.keys = append(collector.keys, &metaKey{
+		name:        keyName,
+		isPrimary:   isPrimary,
+		isUnique:    isUnique,
+		columnNames: []string{columnName}})
+}
+
+//apply write collected keys into table definition; non unique key which name
+//found in skipNames is ignored
+func (collector *metaKeyCollector) apply(tableDef *TableDefinition, skipNames map[string]bool) {
+	for _, key := range collector.keys {
+		if key.isPrimary {
+			tableDef.PrimaryKey = key.columnNames
+		} else if key.isUnique {
+			tableDef.UniqueKeys = append(tableDef.UniqueKeys, UniqueKeyDefinition{ColumnNames: key.columnNames})
+		} else if !skipNames[key.name] {
+			tableDef.Indices = append(tableDef.Indices, IndexKeyDefinition{ColumnNames: key.columnNames})
+		}
+	}
+}
+
+//foreignKeyNames get foreign key names of table definition
+func foreignKeyNames(tableDef *TableDefinition) map[string]bool {
+	result := map[string]bool{}
+	for _, fk := range tableDef.ForiegnKeys {
+		result[fk.Name] = true
+	}
+
+	return result
+}
+
+//appendForeignKeyColumn append column mapping into named foreign key; foreign key is created if not exists
+func appendForeignKeyColumn(tableDef *TableDefinition, fkName string, refTableName string,
+	columnName string, refColumnName string) {
+
+	column := FKColumnDefinition{ColumnName: columnName, RefColumnName: refColumnName}
+
+	for index := range tableDef.ForiegnKeys {
+		if strings.Compare(tableDef.ForiegnKeys[index].Name, fkName) == 0 {
+			tableDef.ForiegnKeys[index].Columns = append(tableDef.ForiegnKeys[index].Columns, column)
+			return
+		}
+	}
+
+	tableDef.ForiegnKeys = append(tableDef.ForiegnKeys, ForeignKeyDefinition{
+		Name:               fkName,
+		ReferenceTableName: refTableName,
+		Columns:            []FKColumnDefinition{column}})
+}
+
+//newViewDefinitionFromSQL create view definition from view name and its SELECT statement
+//written in specified dialect
+func newViewDefinitionFromSQL(viewName string, query string, dialect Dialect) (*ViewDefinition, error) {
+	selectDef, err := ParseSelectDefinitionDialect(query, dialect)
+	if err != nil {
+		return nil, fmt.Errorf("view (%s) query cannot be parsed into query builder: %s",
+			viewName, err.Error())
+	}
+
+	builder := NewQueryBuilder()
+	builder.selectDefinition = selectDef
+
+	return &ViewDefinition{Name: viewName, Query: builder}, nil
+}
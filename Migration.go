@@ -0,0 +1,142 @@
+package rdbmstool
+
+import (
+	"errors"
+	"fmt"
+)
+
+//MigrationStep a single schema change step; generate SQL statements with specified dialect
+type MigrationStep interface {
+	Statements(dialect Dialect) ([]string, error)
+}
+
+//MigrationStepFunc adapter to use ordinary function as migration step
+type MigrationStepFunc func(dialect Dialect) ([]string, error)
+
+//Statements generate SQL statements by calling the function itself
+func (fn MigrationStepFunc) Statements(dialect Dialect) ([]string, error) {
+	return fn(dialect)
+}
+
+//Migration a versioned schema migration with up and down direction
+type Migration struct {
+	Version     int64 //unique and positive; migrations are applied in ascending version
+	Description string
+	Up          []MigrationStep
+	Down        []MigrationStep //empty means migration is irreversible
+}
+
+//NewMigration create new migration instance
+func NewMigration(version int64, description string) *Migration {
+	return &Migration{
+		Version:     version,
+		Description: description,
+		Up:          []MigrationStep{},
+		Down:        []MigrationStep{}}
+}
+
+//AddUp append up direction step
+func (migration *Migration) AddUp(steps ...MigrationStep) *Migration {
+	migration.Up = append(migration.Up, steps...)
+	return migration
+}
+
+//AddDown append down direction step
+func (migration *Migration) AddDown(steps ...MigrationStep) *Migration {
+	migration.Down = append(migration.Down, steps...)
+	return migration
+}
+
+//MigrationSQL raw SQL statement(s) step; statements are executed as it is for every dialect
+func MigrationSQL(statements ...string) MigrationStep {
+	return MigrationStepFunc(func(dialect Dialect) ([]string, error) {
+		return statements, nil
+	})
+}
+
+//MigrationCreateTable CREATE TABLE step from table builder
+func MigrationCreateTable(builder *TableBuilder) MigrationStep {
+	return MigrationStepFunc(func(dialect Dialect) ([]string, error) {
+		return builder.GetTableDefinition().Statements(dialect)
+	})
+}
+
+//MigrationDropTable DROP TABLE step
+func MigrationDropTable(tableName string) MigrationStep {
+	return MigrationStepFunc(func(dialect Dialect) ([]string, error) {
+		return []string{"DROP TABLE " + dialect.QuoteIdentifier(tableName) + ";"}, nil
+	})
+}
+
+//MigrationCreateView CREATE VIEW step from view definition
+func MigrationCreateView(viewDef *ViewDefinition) MigrationStep {
+	return MigrationStepFunc(func(dialect Dialect) ([]string, error) {
+		sql, err := viewDef.SQLDialect(dialect)
+		if err != nil {
+			return nil, err
+		}
+
+		return []string{sql + ";"}, nil
+	})
+}
+
+//MigrationDropView DROP VIEW step
+func MigrationDropView(viewName string) MigrationStep {
+	return MigrationStepFunc(func(dialect Dialect) ([]string, error) {
+		return []string{"DROP VIEW " + dialect.QuoteIdentifier(viewName) + ";"}, nil
+	})
+}
+
+//MigrationAlterTable ALTER TABLE step generated from difference of old and new table definition
+func MigrationAlterTable(oldDef *TableDefinition, newDef *TableDefinition) MigrationStep {
+	return MigrationStepFunc(func(dialect Dialect) ([]string, error) {
+		alter, err := NewAlterTableDefinition(oldDef, newDef)
+		if err != nil {
+			return nil, err
+		}
+
+		return alter.Statements(dialect)
+	})
+}
+
+//statements generate SQL statements of every step in specified direction
+func (migration *Migration) statements(dialect Dialect, isUp bool) ([]string, error) {
+	steps := migration.Up
+	if !isUp {
+		steps = migration.Down
+	}
+
+	if !isUp && len(steps) == 0 {
+		return nil, fmt.Errorf("migration %d (%s) is irreversible", migration.Version, migration.Description)
+	}
+
+	result := []string{}
+	for index, step := range steps {
+		if step == nil {
+			return nil, fmt.Errorf("migration %d step (index %d) cannot be null", migration.Version, index)
+		}
+
+		statements, err := step.Statements(dialect)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to generate migration %d step (index %d) SQL string: %s",
+				migration.Version, index, err.Error())
+		}
+
+		result = append(result, statements...)
+	}
+
+	return result, nil
+}
+
+//Validate check migration integrity
+func (migration *Migration) Validate() error {
+	if migration.Version <= 0 {
+		return fmt.Errorf("migration version must be positive number: %d", migration.Version)
+	}
+
+	if len(migration.Up) == 0 {
+		return errors.New("migration must atleast have one up step")
+	}
+
+	return nil
+}
@@ -0,0 +1,388 @@
+package rdbmstool
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const defaultMigrationTable = "schema_migration"
+
+//dbBeginner database handler which able to start transaction (example: sql.DB)
+type dbBeginner interface {
+	Begin() (*sql.Tx, error)
+}
+
+//Migrator run versioned migrations through DbHandlerProxy; applied versions are
+//recorded in bookkeeping table
+type Migrator struct {
+	db         DbHandlerProxy
+	dialect    Dialect
+	tableName  string
+	migrations []Migration
+	dryRun     io.Writer
+}
+
+//NewMigrator create migration runner; bookkeeping table default to schema_migration
+func NewMigrator(db DbHandlerProxy, dialect Dialect) *Migrator {
+	return &Migrator{
+		db:         db,
+		dialect:    resolveDialect(dialect),
+		tableName:  defaultMigrationTable,
+		migrations: []Migration{},
+		dryRun:     nil}
+}
+
+//TableName set bookkeeping table name
+func (migrator *Migrator) TableName(tableName string) *Migrator {
+	migrator.tableName = tableName
+	return migrator
+}
+
+//Add append migrations
+func (migrator *Migrator) Add(migrations ...*Migration) *Migrator {
+	for _, migration := range migrations {
+		migrator.migrations = append(migrator.migrations, *migration)
+	}
+
+	return migrator
+}
+
+//DryRun print SQL statements into writer instead of executing them; set nil to disable;
+//applied versions are still read from database if bookkeeping table exists
+func (migrator *Migrator) DryRun(writer io.Writer) *Migrator {
+	migrator.dryRun = writer
+	return migrator
+}
+
+//BookkeepingTable get bookkeeping table definition
+func (migrator *Migrator) BookkeepingTable() *TableBuilder {
+	return NewTableBuilder().
+		TableName(migrator.tableName).
+		AddColumnVarchar("version", 20, false).
+		AddColumnVarchar("description", 255, false).
+		AddColumnDateTime("applied_on", false).
+		AddPrimaryKey("version")
+}
+
+//Validate check every migration integrity and version uniqueness
+func (migrator *Migrator) Validate() error {
+	v := &validator{}
+	versions := map[int64]bool{}
+
+	for index := range migrator.migrations {
+		migration := &migrator.migrations[index]
+		field := fmt.Sprintf("migration[%d](%d)", index, migration.Version)
+
+		if versions[migration.Version] {
+			v.add(field, "duplicate migration version")
+		}
+		versions[migration.Version] = true
+
+		v.merge(field, migration.Validate())
+	}
+
+	return v.result()
+}
+
+//AppliedVersions get applied migration versions in ascending order
+func (migrator *Migrator) AppliedVersions() ([]int64, error) {
+	exists, err := migrator.tableExists()
+	if err != nil || !exists {
+		return []int64{}, err
+	}
+
+	query, _, err := NewQueryBuilder().
+		Dialect(migrator.dialect).
+		Select("version", "").
+		From(migrator.tableName, "").
+		Build()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := migrator.db.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []int64{}
+	for rows.Next() {
+		var version string
+		if err := rows.Scan(&version); err != nil {
+			return nil, err
+		}
+
+		number, err := strconv.ParseInt(strings.TrimSpace(version), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration version (%s) found in %s: %s",
+				version, migrator.tableName, err.Error())
+		}
+
+		result = append(result, number)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+
+	return result, nil
+}
+
+//Up apply all pending migrations
+func (migrator *Migrator) Up() error {
+	return migrator.UpTo(0)
+}
+
+//UpTo apply pending migrations up to (and include) specified version; 0 means latest version
+func (migrator *Migrator) UpTo(version int64) error {
+	if err := migrator.Validate(); err != nil {
+		return err
+	}
+
+	applied, err := migrator.appliedSet()
+	if err != nil {
+		return err
+	}
+
+	if err := migrator.ensureTable(); err != nil {
+		return err
+	}
+
+	for _, migration := range migrator.sorted() {
+		if version > 0 && migration.Version > version {
+			break
+		}
+
+		if applied[migration.Version] {
+			continue
+		}
+
+		if err := migrator.run(migration, true); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//Down rollback latest applied migration
+func (migrator *Migrator) Down() error {
+	applied, err := migrator.AppliedVersions()
+	if err != nil {
+		return err
+	}
+
+	if len(applied) == 0 {
+		return nil
+	}
+
+	if len(applied) == 1 {
+		return migrator.DownTo(0)
+	}
+
+	return migrator.DownTo(applied[len(applied)-2])
+}
+
+//DownTo rollback applied migrations which version is greater than specified version;
+//0 means rollback all applied migrations
+func (migrator *Migrator) DownTo(version int64) error {
+	if err := migrator.Validate(); err != nil {
+		return err
+	}
+
+	applied, err := migrator.appliedSet()
+	if err != nil {
+		return err
+	}
+
+	sorted := migrator.sorted()
+	for index := len(sorted) - 1; index >= 0; index-- {
+		migration := sorted[index]
+		if migration.Version <= version {
+			break
+		}
+
+		if !applied[migration.Version] {
+			continue
+		}
+
+		if err := migrator.run(migration, false); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//sorted get migrations sorted by version in ascending order
+func (migrator *Migrator) sorted() []*Migration {
+	result := make([]*Migration, len(migrator.migrations))
+	for index := range migrator.migrations {
+		result[index] = &migrator.migrations[index]
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Version < result[j].Version })
+
+	return result
+}
+
+func (migrator *Migrator) appliedSet() (map[int64]bool, error) {
+	versions, err := migrator.AppliedVersions()
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[int64]bool{}
+	for _, version := range versions {
+		result[version] = true
+	}
+
+	return result, nil
+}
+
+func (migrator *Migrator) tableExists() (bool, error) {
+	if migrator.db == nil {
+		if migrator.dryRun != nil {
+			return false, nil
+		}
+
+		return false, errors.New("database handler cannot be null")
+	}
+
+	var count int
+	if err := migrator.db.QueryRow(
+		migrator.dialect.TableExistsQuery(), migrator.tableName).Scan(&count); err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+//ensureTable create bookkeeping table if not exists
+func (migrator *Migrator) ensureTable() error {
+	exists, err := migrator.tableExists()
+	if err != nil || exists {
+		return err
+	}
+
+	statements, err := migrator.BookkeepingTable().GetTableDefinition().Statements(migrator.dialect)
+	if err != nil {
+		return err
+	}
+
+	if migrator.dryRun != nil {
+		return migrator.print("bookkeeping table", statements, nil)
+	}
+
+	for _, statement := range statements {
+		if _, err := migrator.db.Exec(statement); err != nil {
+			return fmt.Errorf("Failed to create migration bookkeeping table (%s): %s",
+				migrator.tableName, err.Error())
+		}
+	}
+
+	return nil
+}
+
+//run execute migration in specified direction and record it in bookkeeping table;
+//migration is executed within transaction if database handler and dialect support it
+func (migrator *Migrator) run(migration *Migration, isUp bool) error {
+	statements, err := migration.statements(migrator.dialect, isUp)
+	if err != nil {
+		return err
+	}
+
+	recordSQL, recordArgs, err := migrator.recordStatement(migration, isUp)
+	if err != nil {
+		return err
+	}
+
+	direction := "up"
+	if !isUp {
+		direction = "down"
+	}
+
+	if migrator.dryRun != nil {
+		return migrator.print(fmt.Sprintf("migration %d %s: %s", migration.Version, direction,
+			migration.Description), append(statements, recordSQL), recordArgs)
+	}
+
+	beginner, canBegin := migrator.db.(dbBeginner)
+	if !canBegin || !migrator.dialect.TransactionalDDL() {
+		return migrator.execute(migrator.db, migration, direction, statements, recordSQL, recordArgs)
+	}
+
+	tx, err := beginner.Begin()
+	if err != nil {
+		return err
+	}
+
+	if err := migrator.execute(tx, migration, direction, statements, recordSQL, recordArgs); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (migrator *Migrator) execute(db DbHandlerProxy, migration *Migration, direction string,
+	statements []string, recordSQL string, recordArgs []interface{}) error {
+
+	for index, statement := range statements {
+		if _, err := db.Exec(statement); err != nil {
+			return fmt.Errorf("migration %d %s failed at statement (index %d): %s",
+				migration.Version, direction, index, err.Error())
+		}
+	}
+
+	if _, err := db.Exec(recordSQL, recordArgs...); err != nil {
+		return fmt.Errorf("Failed to record migration %d %s: %s", migration.Version, direction, err.Error())
+	}
+
+	return nil
+}
+
+//recordStatement generate bookkeeping INSERT (up) or DELETE (down) statement
+func (migrator *Migrator) recordStatement(migration *Migration, isUp bool) (string, []interface{}, error) {
+	version := strconv.FormatInt(migration.Version, 10)
+
+	if isUp {
+		return NewInsertBuilder().
+			Dialect(migrator.dialect).
+			Into(migrator.tableName).
+			Columns("version", "description", "applied_on").
+			Values(version, migration.Description, time.Now().UTC()).
+			Build()
+	}
+
+	return NewDeleteBuilder().
+		Dialect(migrator.dialect).
+		From(migrator.tableName, "").
+		WhereEqual("version", version).
+		Build()
+}
+
+//print write SQL statements for dry run mode
+func (migrator *Migrator) print(title string, statements []string, args []interface{}) error {
+	output := "-- " + title + "\n"
+	for _, statement := range statements {
+		output = output + statement + "\n"
+	}
+
+	if len(args) > 0 {
+		output = output + fmt.Sprintf("-- args: %v\n", args)
+	}
+
+	_, err := io.WriteString(migrator.dryRun, output+"\n")
+
+	return err
+}
@@ -0,0 +1,131 @@
+package rdbmstool
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func migratorTestMigrations() []*Migration {
+	member := NewTableBuilder().
+		TableName("member").
+		AddColumnInt("id", 11, false).
+		AddColumnVarchar("name", 100, false).
+		AddPrimaryKey("id")
+
+	return []*Migration{
+		NewMigration(2, "add member index").
+			AddUp(MigrationSQL("CREATE INDEX member_name_idx ON member (name);")).
+			AddDown(MigrationSQL("DROP INDEX member_name_idx;")),
+		NewMigration(1, "create member").
+			AddUp(MigrationCreateTable(member)).
+			AddDown(MigrationDropTable("member")),
+	}
+}
+
+func TestMigrator_DryRun(t *testing.T) {
+	output := &bytes.Buffer{}
+
+	err := NewMigrator(nil, NewPostgreSQLDialect()).
+		Add(migratorTestMigrations()...).
+		DryRun(output).
+		Up()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sql := output.String()
+	expectedOrder := []string{
+		"-- bookkeeping table",
+		"CREATE TABLE \"schema_migration\"(",
+		"-- migration 1 up: create member",
+		"CREATE TABLE \"member\"(",
+		"INSERT INTO schema_migration (version, description, applied_on)\nVALUES ($1, $2, $3)",
+		"-- migration 2 up: add member index",
+		"CREATE INDEX member_name_idx ON member (name);",
+	}
+
+	lastIndex := -1
+	for _, expected := range expectedOrder {
+		index := strings.Index(sql, expected)
+		if index <= lastIndex {
+			t.Fatalf("expect dry run output contain (in order):\n%s\n\nbut get:\n\n%s", expected, sql)
+		}
+		lastIndex = index
+	}
+}
+
+func TestMigrator_Up(t *testing.T) {
+	db, fake := newFakeDB()
+	defer db.Close()
+
+	fake.query = func(query string, args []driver.Value) (*fakeResult, error) {
+		if strings.Contains(query, "information_schema.tables") {
+			return newFakeResult([]string{"count"}, []driver.Value{int64(1)}), nil
+		}
+
+		if containsAll(query, "SELECT version", "FROM schema_migration") {
+			return newFakeResult([]string{"version"}, []driver.Value{"1"}), nil
+		}
+
+		return nil, nil
+	}
+
+	fake.exec = func(query string, args []driver.Value) error {
+		if strings.HasPrefix(query, "CREATE INDEX") {
+			return errors.New("index already exists")
+		}
+		return nil
+	}
+
+	err := NewMigrator(db, NewPostgreSQLDialect()).
+		Add(migratorTestMigrations()...).
+		Up()
+	if err == nil {
+		t.Fatal("expect migration 2 failed")
+	}
+
+	if len(fake.execs) != 1 || !strings.HasPrefix(fake.execs[0], "CREATE INDEX") {
+		t.Errorf("expect only migration 2 executed but get:\n%s", strings.Join(fake.execs, "\n"))
+	}
+
+	if fake.rollbacks != 1 || fake.commits != 0 {
+		t.Errorf("expect transaction rolled back but get %d commit(s) and %d rollback(s)",
+			fake.commits, fake.rollbacks)
+	}
+
+	fake.exec = nil
+	fake.execs = nil
+
+	if err := NewMigrator(db, NewPostgreSQLDialect()).Add(migratorTestMigrations()...).Down(); err != nil {
+		t.Fatal(err)
+	}
+
+	expectedExecs := []string{
+		"DROP TABLE \"member\";",
+		"DELETE FROM schema_migration\nWHERE version = $1",
+	}
+
+	if strings.Compare(strings.Join(expectedExecs, "\n"), strings.Join(fake.execs, "\n")) != 0 {
+		t.Errorf("Expect:\n%s\n\nbut get:\n\n%s",
+			strings.Join(expectedExecs, "\n"), strings.Join(fake.execs, "\n"))
+	}
+
+	if fake.commits != 1 {
+		t.Errorf("expect rollback migration committed but get %d commit(s)", fake.commits)
+	}
+}
+
+func TestMigrationDropView(t *testing.T) {
+	statements, err := MigrationDropView("vip_member").Statements(NewPostgreSQLDialect())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedSQL := "DROP VIEW \"vip_member\";"
+	if len(statements) != 1 || strings.Compare(expectedSQL, statements[0]) != 0 {
+		t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expectedSQL, strings.Join(statements, "\n"))
+	}
+}
@@ -0,0 +1,218 @@
+package rdbmstool
+
+import (
+	"fmt"
+	"strings"
+)
+
+//MySQLDialect MySQL (and MariaDB) SQL dialect
+type MySQLDialect struct {
+	Engine    string //table storage engine, default innodb
+	Charset   string //table default character set, default utf8mb4
+	Collation string //table and string column collation, default utf8mb4_unicode_ci
+}
+
+//NewMySQLDialect create MySQL dialect with innodb engine and utf8mb4 character set
+func NewMySQLDialect() *MySQLDialect {
+	return &MySQLDialect{
+		Engine:    "innodb",
+		Charset:   "utf8mb4",
+		Collation: collate}
+}
+
+//Name dialect name
+func (dialect *MySQLDialect) Name() string {
+	return "mysql"
+}
+
+//QuoteIdentifier quote identifier with backquote
+func (dialect *MySQLDialect) QuoteIdentifier(identifier string) string {
+	return "`" + strings.Replace(identifier, "`", "``", -1) + "`"
+}
+
+//AnsiQuotes double quoted text is string
+func (dialect *MySQLDialect) AnsiQuotes() bool {
+	return false
+}
+
+//ColumnType generate MySQL data column type SQL string
+func (dialect *MySQLDialect) ColumnType(colDef *ColumnDefinition) (string, error) {
+	switch colDef.DataType {
+	case CHAR:
+		return fmt.Sprintf("char(%d) COLLATE %s", colDef.Length, dialect.collation()), nil
+	case INTEGER:
+		sqlType := fmt.Sprintf("int(%d)", colDef.Length)
+		if colDef.IntegerSize > 0 {
+			sqlType = fmt.Sprintf("%s(%d)", mysqlIntegerType(colDef.IntegerSize), colDef.Length)
+		}
+		if colDef.IsAutoIncrement {
+			return sqlType + " AUTO_INCREMENT", nil
+		}
+		return sqlType, nil
+	case DECIMAL:
+		return fmt.Sprintf("decimal(%d,%d)", colDef.Length, colDef.DecimalPrecision), nil
+	case FLOAT:
+		return "float", nil
+	case DOUBLE:
+		return "double", nil
+	case TEXT:
+		return fmt.Sprintf("text COLLATE %s", dialect.collation()), nil
+	case DATE:
+		return "date", nil
+	case DATETIME:
+		return "datetime", nil
+	case BOOLEAN:
+		return "tinyint(1)", nil
+	case VARCHAR:
+		return fmt.Sprintf("varchar(%d) COLLATE %s", colDef.Length, dialect.collation()), nil
+	default:
+		return "", fmt.Errorf(
+			"unknown data column (%s) type: %d", colDef.Name, colDef.DataType)
+	}
+}
+
+//mysqlIntegerType get MySQL integer type of integer size
+func mysqlIntegerType(size int) string {
+	switch size {
+	case 1:
+		return "tinyint"
+	case 2:
+		return "smallint"
+	case 3:
+		return "mediumint"
+	case 8:
+		return "bigint"
+	default:
+		return "int"
+	}
+}
+
+//TableOptions generate storage engine, character set, and collation table options
+func (dialect *MySQLDialect) TableOptions() string {
+	engine := dialect.Engine
+	if engine == "" {
+		engine = "innodb"
+	}
+
+	charset := dialect.Charset
+	if charset == "" {
+		charset = "utf8mb4"
+	}
+
+	return fmt.Sprintf("ENGINE=%s DEFAULT CHARSET=%s COLLATE=%s",
+		engine, charset, dialect.collation())
+}
+
+//InlinePrimaryKey primary key always declared with PRIMARY KEY clause
+func (dialect *MySQLDialect) InlinePrimaryKey(tableDef *TableDefinition) bool {
+	return false
+}
+
+//UniqueKey generate UNIQUE KEY clause
+func (dialect *MySQLDialect) UniqueKey(tableName string, columnNames []string) string {
+	return fmt.Sprintf("UNIQUE KEY %s (%s)",
+		dialect.QuoteIdentifier(keyName(columnNames)),
+		quoteIdentifiers(dialect, columnNames))
+}
+
+//InlineIndex MySQL declare index key inside CREATE TABLE statement
+func (dialect *MySQLDialect) InlineIndex() bool {
+	return true
+}
+
+//IndexKey generate KEY clause
+func (dialect *MySQLDialect) IndexKey(tableName string, columnNames []string) string {
+	return fmt.Sprintf("KEY %s (%s)",
+		dialect.QuoteIdentifier(keyName(columnNames)),
+		quoteIdentifiers(dialect, columnNames))
+}
+
+//Limit generate LIMIT ... OFFSET ... SQL string
+func (dialect *MySQLDialect) Limit(limit *LimitDefinition) (string, error) {
+	return fmt.Sprintf("LIMIT %d OFFSET %d", limit.RowCount, limit.Offset), nil
+}
+
+//ValidateQuery all query definition is supported
+func (dialect *MySQLDialect) ValidateQuery(query *SelectDefinition) error {
+	return nil
+}
+
+func (dialect *MySQLDialect) collation() string {
+	if dialect.Collation == "" {
+		return collate
+	}
+
+	return dialect.Collation
+}
+
+//Placeholder generate question mark (?) placeholder
+func (dialect *MySQLDialect) Placeholder(index int) string {
+	return "?"
+}
+
+//UpdateJoin join other table(s) with UPDATE ... JOIN ... SET syntax
+func (dialect *MySQLDialect) UpdateJoin() UpdateJoinStyle {
+	return UpdateJoinInline
+}
+
+//DeleteJoin join other table(s) with DELETE alias FROM ... JOIN syntax
+func (dialect *MySQLDialect) DeleteJoin() DeleteJoinStyle {
+	return DeleteJoinTarget
+}
+
+//DeleteLimit MySQL support DELETE ... LIMIT n on single table
+func (dialect *MySQLDialect) DeleteLimit() bool {
+	return true
+}
+
+//Upsert insert or update row with INSERT ... ON DUPLICATE KEY UPDATE syntax
+func (dialect *MySQLDialect) Upsert() UpsertStyle {
+	return UpsertOnDuplicateKey
+}
+
+//AlterTable generate MySQL ALTER TABLE statement
+func (dialect *MySQLDialect) AlterTable(tableName string, operation *AlterTableOperation) (string, error) {
+	prefix := "ALTER TABLE " + dialect.QuoteIdentifier(tableName) + " "
+
+	switch operation.Type {
+	case AddColumn, ModifyColumn:
+		colSQL, err := columnSQL(dialect, operation.Column)
+		if err != nil {
+			return "", err
+		}
+
+		if operation.Type == AddColumn {
+			return prefix + "ADD COLUMN " + colSQL + ";", nil
+		}
+		return prefix + "MODIFY COLUMN " + colSQL + ";", nil
+	case DropColumn:
+		return prefix + "DROP COLUMN " + dialect.QuoteIdentifier(operation.Column.Name) + ";", nil
+	case AddPrimaryKey:
+		return prefix + "ADD PRIMARY KEY(" + quoteIdentifiers(dialect, operation.ColumnNames) + ");", nil
+	case DropPrimaryKey:
+		return prefix + "DROP PRIMARY KEY;", nil
+	case AddUniqueKey:
+		return prefix + "ADD " + dialect.UniqueKey(tableName, operation.ColumnNames) + ";", nil
+	case AddIndexKey:
+		return prefix + "ADD " + dialect.IndexKey(tableName, operation.ColumnNames) + ";", nil
+	case DropUniqueKey, DropIndexKey:
+		return prefix + "DROP INDEX " + dialect.QuoteIdentifier(keyName(operation.ColumnNames)) + ";", nil
+	case AddForeignKey:
+		return prefix + "ADD " + foreignKeySQL(dialect, operation.ForeignKeyName, operation.ForeignKey) + ";", nil
+	case DropForeignKey:
+		return prefix + "DROP FOREIGN KEY " + dialect.QuoteIdentifier(operation.ForeignKeyName) + ";", nil
+	default:
+		return "", fmt.Errorf("unknown ALTER TABLE operation type: %d", operation.Type)
+	}
+}
+
+//TransactionalDDL MySQL DDL statement cause implicit commit
+func (dialect *MySQLDialect) TransactionalDDL() bool {
+	return false
+}
+
+//TableExistsQuery count data table from information_schema of current database
+func (dialect *MySQLDialect) TableExistsQuery() string {
+	return "SELECT COUNT(*) FROM information_schema.tables " +
+		"WHERE table_schema = DATABASE() AND table_type = 'BASE TABLE' AND table_name = ?"
+}
@@ -0,0 +1,74 @@
+package rdbmstool
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMySQLDialect_TableOptions(t *testing.T) {
+	dialect := &MySQLDialect{
+		Engine:    "myisam",
+		Charset:   "latin1",
+		Collation: "latin1_swedish_ci"}
+
+	builder := NewTableBuilder().
+		Dialect(dialect).
+		TableName("ledger").
+		AddColumnInt("id", 11, false).
+		AddColumnVarchar("code", 20, false).
+		AddColumn("amount", DOUBLE, 0, true, 0).
+		AddPrimaryKey("id")
+
+	expectedSQL := "CREATE TABLE `ledger`(\n" +
+		"`id` int(11) NOT NULL,\n" +
+		"`code` varchar(20) COLLATE latin1_swedish_ci NOT NULL,\n" +
+		"`amount` double NULL,\n" +
+		"PRIMARY KEY(`id`)\n" +
+		") ENGINE=myisam DEFAULT CHARSET=latin1 COLLATE=latin1_swedish_ci;"
+
+	sql, err := builder.SQL()
+	if err != nil {
+		t.Error(err)
+	}
+
+	if strings.Compare(expectedSQL, sql) != 0 {
+		t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expectedSQL, sql)
+	}
+}
+
+func TestMySQLDialect_Limit(t *testing.T) {
+	sql, err := NewLimitDefinition(10, 20).SQLDialect(NewMySQLDialect())
+	if err != nil {
+		t.Error(err)
+	}
+
+	if strings.Compare("LIMIT 10 OFFSET 20", sql) != 0 {
+		t.Errorf("Expect LIMIT 10 OFFSET 20 but get %s", sql)
+	}
+}
+
+func TestMySQLDialect_ColumnType_integer(t *testing.T) {
+	dialect := NewMySQLDialect()
+
+	colDefs := map[string]ColumnDefinition{
+		"int(3)":                 {Name: "a", DataType: INTEGER, Length: 3},
+		"int(6)":                 {Name: "b", DataType: INTEGER, Length: 6},
+		"int(20)":                {Name: "c", DataType: INTEGER, Length: 20},
+		"tinyint(3)":             {Name: "d", DataType: INTEGER, Length: 3, IntegerSize: 1},
+		"smallint(5)":            {Name: "e", DataType: INTEGER, Length: 5, IntegerSize: 2},
+		"mediumint(8)":           {Name: "f", DataType: INTEGER, Length: 8, IntegerSize: 3},
+		"bigint(20)":             {Name: "g", DataType: INTEGER, Length: 20, IntegerSize: 8},
+		"int(11) AUTO_INCREMENT": {Name: "h", DataType: INTEGER, Length: 11, IsAutoIncrement: true}}
+
+	for expected, colDef := range colDefs {
+		sqlType, err := dialect.ColumnType(&colDef)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+
+		if strings.Compare(expected, sqlType) != 0 {
+			t.Errorf("Expect %s but get %s", expected, sqlType)
+		}
+	}
+}
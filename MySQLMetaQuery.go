@@ -0,0 +1,239 @@
+package rdbmstool
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+//MySQLMetaQuery MySQL implementation of MetaQuery; backed by information_schema
+type MySQLMetaQuery struct{}
+
+//NewMySQLMetaQuery create MySQL meta query
+func NewMySQLMetaQuery() *MySQLMetaQuery {
+	return &MySQLMetaQuery{}
+}
+
+//GetTableNames get data table names of database which match name pattern
+func (meta *MySQLMetaQuery) GetTableNames(db DbHandlerProxy, databaseName string, tableNamePattern string) ([]string, error) {
+	return queryStrings(db,
+		"SELECT table_name FROM information_schema.tables "+
+			"WHERE table_schema = ? AND table_type = 'BASE TABLE' AND table_name LIKE ? "+
+			"ORDER BY table_name",
+		databaseName, tableNamePattern)
+}
+
+//GetTableNamesByPattern get data table names of databases which name match regular expression
+func (meta *MySQLMetaQuery) GetTableNamesByPattern(db DbHandlerProxy, databaseNamePattern string, tableNamePattern string) ([]string, error) {
+	return queryStrings(db,
+		"SELECT DISTINCT table_name FROM information_schema.tables "+
+			"WHERE table_schema REGEXP ? AND table_type = 'BASE TABLE' AND table_name LIKE ? "+
+			"ORDER BY table_name",
+		databaseNamePattern, tableNamePattern)
+}
+
+//GetTableDefinition read data table definition; include columns, primary key,
+//unique keys, indices, and foreign keys
+func (meta *MySQLMetaQuery) GetTableDefinition(db DbHandlerProxy, databaseName string, tableName string) (*TableDefinition, error) {
+	tableDef := &TableDefinition{
+		Name:        tableName,
+		Columns:     []ColumnDefinition{},
+		PrimaryKey:  []string{},
+		ForiegnKeys: []ForeignKeyDefinition{},
+		UniqueKeys:  []UniqueKeyDefinition{},
+		Indices:     []IndexKeyDefinition{}}
+
+	if err := meta.readColumns(db, databaseName, tableDef); err != nil {
+		return nil, err
+	}
+
+	if len(tableDef.Columns) == 0 {
+		return nil, fmt.Errorf("table (%s) not found in database (%s)", tableName, databaseName)
+	}
+
+	if err := meta.readForeignKeys(db, databaseName, tableDef); err != nil {
+		return nil, err
+	}
+
+	if err := meta.readKeys(db, databaseName, tableDef); err != nil {
+		return nil, err
+	}
+
+	return tableDef, nil
+}
+
+func (meta *MySQLMetaQuery) readColumns(db DbHandlerProxy, databaseName string, tableDef *TableDefinition) error {
+	rows, err := db.Query(
+		"SELECT column_name, data_type, column_type, character_maximum_length, "+
+			"numeric_precision, numeric_scale, is_nullable, extra "+
+			"FROM information_schema.columns "+
+			"WHERE table_schema = ? AND table_name = ? "+
+			"ORDER BY ordinal_position",
+		databaseName, tableDef.Name)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var name, dataType, columnType, isNullable, extra string
+		var charLength, numPrecision, numScale sql.NullInt64
+
+		if err := rows.Scan(&name, &dataType, &columnType, &charLength,
+			&numPrecision, &numScale, &isNullable, &extra); err != nil {
+			return err
+		}
+
+		colDef := ColumnDefinition{
+			Name:            name,
+			IsNullable:      strings.EqualFold(isNullable, "YES"),
+			IsAutoIncrement: strings.Contains(strings.ToLower(extra), "auto_increment")}
+
+		if err := meta.mapColumnType(&colDef, dataType, columnType,
+			charLength.Int64, numPrecision.Int64, numScale.Int64); err != nil {
+			return fmt.Errorf("table (%s) column (%s): %s", tableDef.Name, name, err.Error())
+		}
+
+		tableDef.Columns = append(tableDef.Columns, colDef)
+	}
+
+	return rows.Err()
+}
+
+var mysqlDisplayWidth = regexp.MustCompile(`\((\d+)\)`)
+
+//mysqlIntegerSize storage size in byte of MySQL integer types other than int
+var mysqlIntegerSize = map[string]int{
+	"tinyint":   1,
+	"smallint":  2,
+	"mediumint": 3,
+	"bigint":    8}
+
+//mapColumnType map MySQL native data type onto ColumnDataType
+func (meta *MySQLMetaQuery) mapColumnType(colDef *ColumnDefinition, dataType string, columnType string,
+	charLength int64, numPrecision int64, numScale int64) error {
+
+	switch strings.ToLower(dataType) {
+	case "char":
+		colDef.DataType, colDef.Length = CHAR, int(charLength)
+	case "varchar":
+		colDef.DataType, colDef.Length = VARCHAR, int(charLength)
+	case "tinyint", "smallint", "mediumint", "int", "integer", "bigint":
+		//MySQL 8 drops display width, numeric precision is used instead
+		width := int(numPrecision)
+		if match := mysqlDisplayWidth.FindStringSubmatch(columnType); match != nil {
+			width, _ = strconv.Atoi(match[1])
+		}
+
+		if strings.EqualFold(dataType, "tinyint") && width == 1 {
+			colDef.DataType = BOOLEAN
+		} else {
+			colDef.DataType, colDef.Length, colDef.IntegerSize =
+				INTEGER, width, mysqlIntegerSize[strings.ToLower(dataType)]
+		}
+	case "decimal", "numeric":
+		colDef.DataType, colDef.Length, colDef.DecimalPrecision = DECIMAL, int(numPrecision), int(numScale)
+	case "float":
+		colDef.DataType = FLOAT
+	case "double", "real":
+		colDef.DataType = DOUBLE
+	case "tinytext", "text", "mediumtext", "longtext":
+		colDef.DataType = TEXT
+	case "date":
+		colDef.DataType = DATE
+	case "datetime", "timestamp":
+		colDef.DataType = DATETIME
+	case "bit", "bool", "boolean":
+		colDef.DataType = BOOLEAN
+	default:
+		return fmt.Errorf("unsupported data type: %s", columnType)
+	}
+
+	return nil
+}
+
+func (meta *MySQLMetaQuery) readKeys(db DbHandlerProxy, databaseName string, tableDef *TableDefinition) error {
+	rows, err := db.Query(
+		"SELECT index_name, non_unique, column_name "+
+			"FROM information_schema.statistics "+
+			"WHERE table_schema = ? AND table_name = ? "+
+			"ORDER BY index_name, seq_in_index",
+		databaseName, tableDef.Name)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	keys := &metaKeyCollector{}
+	for rows.Next() {
+		var indexName, columnName string
+		var nonUnique int
+
+		if err := rows.Scan(&indexName, &nonUnique, &columnName); err != nil {
+			return err
+		}
+
+		keys.add(indexName, strings.EqualFold(indexName, "PRIMARY"), nonUnique == 0, columnName)
+	}
+
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	//InnoDB create index for foreign key automatically; skip it
+	keys.apply(tableDef, foreignKeyNames(tableDef))
+
+	return nil
+}
+
+func (meta *MySQLMetaQuery) readForeignKeys(db DbHandlerProxy, databaseName string, tableDef *TableDefinition) error {
+	rows, err := db.Query(
+		"SELECT constraint_name, column_name, referenced_table_name, referenced_column_name "+
+			"FROM information_schema.key_column_usage "+
+			"WHERE table_schema = ? AND table_name = ? AND referenced_table_name IS NOT NULL "+
+			"ORDER BY constraint_name, ordinal_position",
+		databaseName, tableDef.Name)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var fkName, columnName, refTableName, refColumnName string
+
+		if err := rows.Scan(&fkName, &columnName, &refTableName, &refColumnName); err != nil {
+			return err
+		}
+
+		appendForeignKeyColumn(tableDef, fkName, refTableName, columnName, refColumnName)
+	}
+
+	return rows.Err()
+}
+
+//GetViewNames get view names of database which match name pattern
+func (meta *MySQLMetaQuery) GetViewNames(db DbHandlerProxy, databaseName string, viewNamePattern string) ([]string, error) {
+	return queryStrings(db,
+		"SELECT table_name FROM information_schema.views "+
+			"WHERE table_schema = ? AND table_name LIKE ? "+
+			"ORDER BY table_name",
+		databaseName, viewNamePattern)
+}
+
+//GetViewDefinition read view definition; view query is parsed into query builder
+func (meta *MySQLMetaQuery) GetViewDefinition(db DbHandlerProxy, databaseName string, viewName string) (*ViewDefinition, error) {
+	var query string
+	err := db.QueryRow(
+		"SELECT view_definition FROM information_schema.views "+
+			"WHERE table_schema = ? AND table_name = ?",
+		databaseName, viewName).Scan(&query)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("view (%s) not found in database (%s)", viewName, databaseName)
+	} else if err != nil {
+		return nil, err
+	}
+
+	return newViewDefinitionFromSQL(viewName, query, NewMySQLDialect())
+}
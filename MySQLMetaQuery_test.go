@@ -0,0 +1,174 @@
+package rdbmstool
+
+import (
+	"database/sql/driver"
+	"strings"
+	"testing"
+)
+
+func TestMySQLMetaQuery_GetTableDefinition(t *testing.T) {
+	db, fake := newFakeDB()
+	defer db.Close()
+
+	fake.query = func(query string, args []driver.Value) (*fakeResult, error) {
+		if strings.Contains(query, "information_schema.columns") {
+			return newFakeResult(
+				[]string{"column_name", "data_type", "column_type", "character_maximum_length",
+					"numeric_precision", "numeric_scale", "is_nullable", "extra"},
+				[]driver.Value{"id", "int", "int(11)", nil, int64(10), int64(0), "NO", "auto_increment"},
+				[]driver.Value{"code", "varchar", "varchar(20)", int64(20), nil, nil, "NO", ""},
+				[]driver.Value{"amount", "decimal", "decimal(10,2)", nil, int64(10), int64(2), "YES", ""},
+				[]driver.Value{"is_paid", "tinyint", "tinyint(1)", nil, int64(3), int64(0), "NO", ""},
+				[]driver.Value{"customer_id", "int", "int", nil, int64(10), int64(0), "NO", ""},
+				[]driver.Value{"branch_id", "int", "int(11)", nil, int64(10), int64(0), "NO", ""},
+				[]driver.Value{"serial_no", "bigint", "bigint(20) unsigned", nil, int64(20), int64(0), "NO", ""},
+				[]driver.Value{"qty", "smallint", "smallint", nil, int64(5), int64(0), "NO", ""},
+				[]driver.Value{"level", "tinyint", "tinyint(3) unsigned", nil, int64(3), int64(0), "NO", ""},
+				[]driver.Value{"line_no", "mediumint", "mediumint(8)", nil, int64(7), int64(0), "NO", ""},
+				[]driver.Value{"seq", "int", "int(5)", nil, int64(10), int64(0), "NO", ""},
+			), nil
+		}
+
+		if strings.Contains(query, "information_schema.statistics") {
+			return newFakeResult([]string{"index_name", "non_unique", "column_name"},
+				[]driver.Value{"PRIMARY", int64(0), "id"},
+				[]driver.Value{"code", int64(0), "code"},
+				[]driver.Value{"fk_invoice_customer", int64(1), "customer_id"},
+				[]driver.Value{"fk_invoice_customer", int64(1), "branch_id"},
+				[]driver.Value{"is_paid", int64(1), "is_paid"},
+			), nil
+		}
+
+		if strings.Contains(query, "information_schema.key_column_usage") {
+			return newFakeResult(
+				[]string{"constraint_name", "column_name", "referenced_table_name", "referenced_column_name"},
+				[]driver.Value{"fk_invoice_customer", "customer_id", "customer", "id"},
+				[]driver.Value{"fk_invoice_customer", "branch_id", "customer", "branch_id"},
+			), nil
+		}
+
+		return nil, nil
+	}
+
+	var meta MetaQuery = NewMySQLMetaQuery()
+
+	tableDef, err := meta.GetTableDefinition(db, "shop", "invoice")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sql, err := tableDef.SQL()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedSQL := "CREATE TABLE `invoice`(\n" +
+		"`id` int(11) AUTO_INCREMENT NOT NULL,\n" +
+		"`code` varchar(20) COLLATE utf8mb4_unicode_ci NOT NULL,\n" +
+		"`amount` decimal(10,2) NULL,\n" +
+		"`is_paid` tinyint(1) NOT NULL,\n" +
+		"`customer_id` int(10) NOT NULL,\n" +
+		"`branch_id` int(11) NOT NULL,\n" +
+		"`serial_no` bigint(20) NOT NULL,\n" +
+		"`qty` smallint(5) NOT NULL,\n" +
+		"`level` tinyint(3) NOT NULL,\n" +
+		"`line_no` mediumint(8) NOT NULL,\n" +
+		"`seq` int(5) NOT NULL,\n" +
+		"PRIMARY KEY(`id`),\n" +
+		"UNIQUE KEY `code` (`code`),\n" +
+		"KEY `is_paid` (`is_paid`),\n" +
+		"CONSTRAINT `fk_invoice_customer` FOREIGN KEY (`customer_id`,`branch_id`) REFERENCES `customer` (`id`,`branch_id`)\n" +
+		") ENGINE=innodb DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;"
+
+	if strings.Compare(expectedSQL, sql) != 0 {
+		t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expectedSQL, sql)
+	}
+}
+
+func TestMySQLMetaQuery_GetTableNames(t *testing.T) {
+	db, fake := newFakeDB()
+	defer db.Close()
+
+	fake.query = func(query string, args []driver.Value) (*fakeResult, error) {
+		if containsAll(query, "information_schema.tables", "table_schema = ?") &&
+			args[0] == "shop" && args[1] == "inv%" {
+			return newFakeResult([]string{"table_name"},
+				[]driver.Value{"invoice"}, []driver.Value{"invoice_item"}), nil
+		}
+
+		return nil, nil
+	}
+
+	names, err := NewMySQLMetaQuery().GetTableNames(db, "shop", "inv%")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Compare("invoice,invoice_item", strings.Join(names, ",")) != 0 {
+		t.Errorf("Expect invoice,invoice_item but get %s", strings.Join(names, ","))
+	}
+}
+
+func TestMySQLMetaQuery_GetViewDefinition(t *testing.T) {
+	db, fake := newFakeDB()
+	defer db.Close()
+
+	fake.query = func(query string, args []driver.Value) (*fakeResult, error) {
+		if containsAll(query, "information_schema.views") && args[1] == "big_invoice" {
+			return newFakeResult([]string{"view_definition"},
+				[]driver.Value{"select `shop`.`invoice`.`id` AS `id`,`shop`.`invoice`.`amount` AS `amount` " +
+					"from `shop`.`invoice` where (`shop`.`invoice`.`amount` > 100)"}), nil
+		} else if containsAll(query, "information_schema.views") && args[1] == "customer_invoice" {
+			//MySQL store join condition with double parenthesis
+			return newFakeResult([]string{"view_definition"},
+				[]driver.Value{"select `c`.`name` AS `name`,`i`.`amount` AS `amount` " +
+					"from (`shop`.`customer` `c` join `shop`.`invoice` `i` on((`i`.`customer_id` = `c`.`id`))) " +
+					"where ((`i`.`amount` > 100))"}), nil
+		}
+
+		return nil, nil
+	}
+
+	viewDef, err := NewMySQLMetaQuery().GetViewDefinition(db, "shop", "big_invoice")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sql, err := viewDef.SQL()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedSQL := "CREATE VIEW `big_invoice` AS \n" +
+		"SELECT `shop`.`invoice`.`id` AS `id`, `shop`.`invoice`.`amount` AS `amount`\n" +
+		"FROM `shop`.`invoice`\n" +
+		"WHERE `shop`.`invoice`.`amount` > 100"
+
+	if strings.Compare(expectedSQL, sql) != 0 {
+		t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expectedSQL, sql)
+	}
+
+	viewDef, err = NewMySQLMetaQuery().GetViewDefinition(db, "shop", "customer_invoice")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sql, err = viewDef.SQL()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedSQL = "CREATE VIEW `customer_invoice` AS \n" +
+		"SELECT `c`.`name` AS `name`, `i`.`amount` AS `amount`\n" +
+		"FROM `shop`.`customer` AS `c`\n" +
+		"JOIN `shop`.`invoice` AS `i` ON `i`.`customer_id` = `c`.`id`\n" +
+		"WHERE `i`.`amount` > 100"
+
+	if strings.Compare(expectedSQL, sql) != 0 {
+		t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expectedSQL, sql)
+	}
+
+	if _, err := NewMySQLMetaQuery().GetViewDefinition(db, "shop", "payment"); err == nil {
+		t.Error("Expect error for view not exists")
+	}
+}
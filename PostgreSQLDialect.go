@@ -0,0 +1,236 @@
+package rdbmstool
+
+import (
+	"fmt"
+	"strings"
+)
+
+//PostgreSQLDialect PostgreSQL SQL dialect
+type PostgreSQLDialect struct{}
+
+//NewPostgreSQLDialect create PostgreSQL dialect
+func NewPostgreSQLDialect() *PostgreSQLDialect {
+	return &PostgreSQLDialect{}
+}
+
+//Name dialect name
+func (dialect *PostgreSQLDialect) Name() string {
+	return "postgres"
+}
+
+//QuoteIdentifier quote identifier with double quote
+func (dialect *PostgreSQLDialect) QuoteIdentifier(identifier string) string {
+	return "\"" + strings.Replace(identifier, "\"", "\"\"", -1) + "\""
+}
+
+//AnsiQuotes double quoted text is identifier
+func (dialect *PostgreSQLDialect) AnsiQuotes() bool {
+	return true
+}
+
+//ColumnType generate PostgreSQL data column type SQL string
+func (dialect *PostgreSQLDialect) ColumnType(colDef *ColumnDefinition) (string, error) {
+	switch colDef.DataType {
+	case CHAR:
+		return fmt.Sprintf("char(%d)", colDef.Length), nil
+	case INTEGER:
+		sqlType := postgresIntegerType(columnIntegerSize(colDef))
+		if colDef.IsAutoIncrement {
+			return sqlType + " GENERATED BY DEFAULT AS IDENTITY", nil
+		}
+		return sqlType, nil
+	case DECIMAL:
+		return fmt.Sprintf("numeric(%d,%d)", colDef.Length, colDef.DecimalPrecision), nil
+	case FLOAT:
+		return "real", nil
+	case DOUBLE:
+		return "double precision", nil
+	case TEXT:
+		return "text", nil
+	case DATE:
+		return "date", nil
+	case DATETIME:
+		return "timestamp", nil
+	case BOOLEAN:
+		return "boolean", nil
+	case VARCHAR:
+		return fmt.Sprintf("varchar(%d)", colDef.Length), nil
+	default:
+		return "", fmt.Errorf(
+			"unknown data column (%s) type: %d", colDef.Name, colDef.DataType)
+	}
+}
+
+//postgresIntegerType get smallest PostgreSQL integer type which fit integer size;
+//PostgreSQL has no 1 and 3 bytes integer
+func postgresIntegerType(size int) string {
+	switch size {
+	case 1, 2:
+		return "smallint"
+	case 8:
+		return "bigint"
+	default:
+		return "integer"
+	}
+}
+
+//TableOptions PostgreSQL has no table options
+func (dialect *PostgreSQLDialect) TableOptions() string {
+	return ""
+}
+
+//InlinePrimaryKey primary key always declared with PRIMARY KEY clause
+func (dialect *PostgreSQLDialect) InlinePrimaryKey(tableDef *TableDefinition) bool {
+	return false
+}
+
+//UniqueKey generate named UNIQUE constraint clause
+func (dialect *PostgreSQLDialect) UniqueKey(tableName string, columnNames []string) string {
+	return fmt.Sprintf("CONSTRAINT %s UNIQUE (%s)",
+		dialect.QuoteIdentifier(uniqueKeyName(tableName, columnNames)),
+		quoteIdentifiers(dialect, columnNames))
+}
+
+//InlineIndex PostgreSQL not allow index key declared inside CREATE TABLE statement
+func (dialect *PostgreSQLDialect) InlineIndex() bool {
+	return false
+}
+
+//IndexKey generate CREATE INDEX statement
+func (dialect *PostgreSQLDialect) IndexKey(tableName string, columnNames []string) string {
+	return fmt.Sprintf("CREATE INDEX %s ON %s (%s);",
+		dialect.QuoteIdentifier(indexKeyName(tableName, columnNames)),
+		dialect.QuoteIdentifier(tableName),
+		quoteIdentifiers(dialect, columnNames))
+}
+
+//Limit generate LIMIT ... OFFSET ... SQL string
+func (dialect *PostgreSQLDialect) Limit(limit *LimitDefinition) (string, error) {
+	return fmt.Sprintf("LIMIT %d OFFSET %d", limit.RowCount, limit.Offset), nil
+}
+
+//ValidateQuery all query definition is supported
+func (dialect *PostgreSQLDialect) ValidateQuery(query *SelectDefinition) error {
+	return nil
+}
+
+//Placeholder generate numbered placeholder ($1, $2, ...)
+func (dialect *PostgreSQLDialect) Placeholder(index int) string {
+	return fmt.Sprintf("$%d", index)
+}
+
+//UpdateJoin join other table(s) with UPDATE ... SET ... FROM syntax
+func (dialect *PostgreSQLDialect) UpdateJoin() UpdateJoinStyle {
+	return UpdateJoinFrom
+}
+
+//DeleteJoin join other table(s) with DELETE FROM ... USING syntax
+func (dialect *PostgreSQLDialect) DeleteJoin() DeleteJoinStyle {
+	return DeleteJoinUsing
+}
+
+//DeleteLimit DELETE ... LIMIT n is not supported
+func (dialect *PostgreSQLDialect) DeleteLimit() bool {
+	return false
+}
+
+//Upsert insert or update row with INSERT ... ON CONFLICT syntax
+func (dialect *PostgreSQLDialect) Upsert() UpsertStyle {
+	return UpsertOnConflict
+}
+
+//AlterTable generate PostgreSQL ALTER TABLE, CREATE INDEX, or DROP INDEX statement
+func (dialect *PostgreSQLDialect) AlterTable(tableName string, operation *AlterTableOperation) (string, error) {
+	prefix := "ALTER TABLE " + dialect.QuoteIdentifier(tableName) + " "
+
+	switch operation.Type {
+	case AddColumn:
+		colSQL, err := columnSQL(dialect, operation.Column)
+		if err != nil {
+			return "", err
+		}
+		return prefix + "ADD COLUMN " + colSQL + ";", nil
+	case ModifyColumn:
+		return dialect.alterColumn(prefix, operation.OldColumn, operation.Column)
+	case DropColumn:
+		return prefix + "DROP COLUMN " + dialect.QuoteIdentifier(operation.Column.Name) + ";", nil
+	case AddPrimaryKey:
+		return prefix + "ADD PRIMARY KEY(" + quoteIdentifiers(dialect, operation.ColumnNames) + ");", nil
+	case DropPrimaryKey:
+		return prefix + "DROP CONSTRAINT " + dialect.QuoteIdentifier(tableName+"_pkey") + ";", nil
+	case AddUniqueKey:
+		return prefix + "ADD " + dialect.UniqueKey(tableName, operation.ColumnNames) + ";", nil
+	case DropUniqueKey:
+		return prefix + "DROP CONSTRAINT " +
+			dialect.QuoteIdentifier(uniqueKeyName(tableName, operation.ColumnNames)) + ";", nil
+	case AddIndexKey:
+		return dialect.IndexKey(tableName, operation.ColumnNames), nil
+	case DropIndexKey:
+		return "DROP INDEX " + dialect.QuoteIdentifier(indexKeyName(tableName, operation.ColumnNames)) + ";", nil
+	case AddForeignKey:
+		return prefix + "ADD " + foreignKeySQL(dialect, operation.ForeignKeyName, operation.ForeignKey) + ";", nil
+	case DropForeignKey:
+		return prefix + "DROP CONSTRAINT " + dialect.QuoteIdentifier(operation.ForeignKeyName) + ";", nil
+	default:
+		return "", fmt.Errorf("unknown ALTER TABLE operation type: %d", operation.Type)
+	}
+}
+
+//alterColumn generate ALTER COLUMN ... TYPE / SET NOT NULL / identity actions
+func (dialect *PostgreSQLDialect) alterColumn(prefix string,
+	oldCol *ColumnDefinition, newCol *ColumnDefinition) (string, error) {
+
+	oldBase := *oldCol
+	oldBase.IsAutoIncrement = false
+	oldType, oldErr := dialect.ColumnType(&oldBase)
+	if oldErr != nil {
+		return "", oldErr
+	}
+
+	newBase := *newCol
+	newBase.IsAutoIncrement = false
+	newType, newErr := dialect.ColumnType(&newBase)
+	if newErr != nil {
+		return "", newErr
+	}
+
+	column := "ALTER COLUMN " + dialect.QuoteIdentifier(newCol.Name)
+	actions := []string{}
+
+	if strings.Compare(oldType, newType) != 0 {
+		actions = append(actions, column+" TYPE "+newType)
+	}
+
+	if oldCol.IsNullable != newCol.IsNullable {
+		if newCol.IsNullable {
+			actions = append(actions, column+" DROP NOT NULL")
+		} else {
+			actions = append(actions, column+" SET NOT NULL")
+		}
+	}
+
+	if oldCol.IsAutoIncrement != newCol.IsAutoIncrement {
+		if newCol.IsAutoIncrement {
+			actions = append(actions, column+" ADD GENERATED BY DEFAULT AS IDENTITY")
+		} else {
+			actions = append(actions, column+" DROP IDENTITY")
+		}
+	}
+
+	if len(actions) == 0 {
+		return "", nil
+	}
+
+	return prefix + strings.Join(actions, ", ") + ";", nil
+}
+
+//TransactionalDDL PostgreSQL DDL statement can be rolled back
+func (dialect *PostgreSQLDialect) TransactionalDDL() bool {
+	return true
+}
+
+//TableExistsQuery count data table from information_schema of current schema
+func (dialect *PostgreSQLDialect) TableExistsQuery() string {
+	return "SELECT COUNT(*) FROM information_schema.tables " +
+		"WHERE table_schema = current_schema() AND table_type = 'BASE TABLE' AND table_name = $1"
+}
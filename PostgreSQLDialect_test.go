@@ -0,0 +1,46 @@
+package rdbmstool
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPostgreSQLDialect_TableSQL(t *testing.T) {
+	builder := NewTableBuilder().
+		Dialect(NewPostgreSQLDialect()).
+		TableName("account_role").
+		AddColumnInt("id", 10, false).
+		AddColumnInt("account_id", 10, false).
+		AddColumnInt("role_id", 10, false).
+		AddColumnDecimal("quota", 10, 2, true).
+		AddColumnBoolean("is_active", false).
+		AddColumnDateTime("created", false).
+		AddColumn("score", DOUBLE, 0, true, 0).
+		AddPrimaryKey("id").
+		AddUniqueKeyMultiColumn([]string{"account_id", "role_id"}).
+		AddIndexKey("role_id").
+		AddForeignKey("account_id", "account", "id")
+
+	expectedSQL := "CREATE TABLE \"account_role\"(\n" +
+		"\"id\" integer NOT NULL,\n" +
+		"\"account_id\" integer NOT NULL,\n" +
+		"\"role_id\" integer NOT NULL,\n" +
+		"\"quota\" numeric(10,2) NULL,\n" +
+		"\"is_active\" boolean NOT NULL,\n" +
+		"\"created\" timestamp NOT NULL,\n" +
+		"\"score\" double precision NULL,\n" +
+		"PRIMARY KEY(\"id\"),\n" +
+		"CONSTRAINT \"account_role_account_id_role_id_key\" UNIQUE (\"account_id\",\"role_id\"),\n" +
+		"CONSTRAINT \"account_role_ibfk_1\" FOREIGN KEY (\"account_id\") REFERENCES \"account\" (\"id\")\n" +
+		");\n" +
+		"CREATE INDEX \"account_role_role_id_idx\" ON \"account_role\" (\"role_id\");"
+
+	sql, err := builder.SQL()
+	if err != nil {
+		t.Error(err)
+	}
+
+	if strings.Compare(expectedSQL, sql) != 0 {
+		t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expectedSQL, sql)
+	}
+}
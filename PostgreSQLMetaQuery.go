@@ -0,0 +1,227 @@
+package rdbmstool
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+//PostgreSQLMetaQuery PostgreSQL implementation of MetaQuery; backed by information_schema and pg_catalog
+//NOTE: database name parameter refer to schema name (example: public) of connected database
+type PostgreSQLMetaQuery struct{}
+
+//NewPostgreSQLMetaQuery create PostgreSQL meta query
+func NewPostgreSQLMetaQuery() *PostgreSQLMetaQuery {
+	return &PostgreSQLMetaQuery{}
+}
+
+//GetTableNames get data table names of schema which match name pattern
+func (meta *PostgreSQLMetaQuery) GetTableNames(db DbHandlerProxy, schemaName string, tableNamePattern string) ([]string, error) {
+	return queryStrings(db,
+		"SELECT table_name FROM information_schema.tables "+
+			"WHERE table_schema = $1 AND table_type = 'BASE TABLE' AND table_name LIKE $2 "+
+			"ORDER BY table_name",
+		schemaName, tableNamePattern)
+}
+
+//GetTableNamesByPattern get data table names of schemas which name match regular expression
+func (meta *PostgreSQLMetaQuery) GetTableNamesByPattern(db DbHandlerProxy, schemaNamePattern string, tableNamePattern string) ([]string, error) {
+	return queryStrings(db,
+		"SELECT DISTINCT table_name FROM information_schema.tables "+
+			"WHERE table_schema ~ $1 AND table_type = 'BASE TABLE' AND table_name LIKE $2 "+
+			"ORDER BY table_name",
+		schemaNamePattern, tableNamePattern)
+}
+
+//GetTableDefinition read data table definition; include columns, primary key,
+//unique keys, indices, and foreign keys
+func (meta *PostgreSQLMetaQuery) GetTableDefinition(db DbHandlerProxy, schemaName string, tableName string) (*TableDefinition, error) {
+	tableDef := &TableDefinition{
+		Name:        tableName,
+		Columns:     []ColumnDefinition{},
+		PrimaryKey:  []string{},
+		ForiegnKeys: []ForeignKeyDefinition{},
+		UniqueKeys:  []UniqueKeyDefinition{},
+		Indices:     []IndexKeyDefinition{}}
+
+	if err := meta.readColumns(db, schemaName, tableDef); err != nil {
+		return nil, err
+	}
+
+	if len(tableDef.Columns) == 0 {
+		return nil, fmt.Errorf("table (%s) not found in schema (%s)", tableName, schemaName)
+	}
+
+	if err := meta.readKeys(db, schemaName, tableDef); err != nil {
+		return nil, err
+	}
+
+	if err := meta.readForeignKeys(db, schemaName, tableDef); err != nil {
+		return nil, err
+	}
+
+	return tableDef, nil
+}
+
+func (meta *PostgreSQLMetaQuery) readColumns(db DbHandlerProxy, schemaName string, tableDef *TableDefinition) error {
+	rows, err := db.Query(
+		"SELECT column_name, data_type, character_maximum_length, numeric_precision, numeric_scale, "+
+			"is_nullable, is_identity, COALESCE(column_default, '') "+
+			"FROM information_schema.columns "+
+			"WHERE table_schema = $1 AND table_name = $2 "+
+			"ORDER BY ordinal_position",
+		schemaName, tableDef.Name)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var name, dataType, isNullable, isIdentity, columnDefault string
+		var charLength, numPrecision, numScale sql.NullInt64
+
+		if err := rows.Scan(&name, &dataType, &charLength, &numPrecision, &numScale,
+			&isNullable, &isIdentity, &columnDefault); err != nil {
+			return err
+		}
+
+		colDef := ColumnDefinition{
+			Name:       name,
+			IsNullable: strings.EqualFold(isNullable, "YES"),
+			IsAutoIncrement: strings.EqualFold(isIdentity, "YES") ||
+				strings.HasPrefix(columnDefault, "nextval(")}
+
+		switch strings.ToLower(dataType) {
+		case "character":
+			colDef.DataType, colDef.Length = CHAR, int(charLength.Int64)
+		case "character varying":
+			if charLength.Valid {
+				colDef.DataType, colDef.Length = VARCHAR, int(charLength.Int64)
+			} else {
+				colDef.DataType = TEXT
+			}
+		case "smallint":
+			colDef.DataType, colDef.Length, colDef.IntegerSize = INTEGER, 6, 2
+		case "integer":
+			colDef.DataType, colDef.Length = INTEGER, 11
+		case "bigint":
+			colDef.DataType, colDef.Length, colDef.IntegerSize = INTEGER, 20, 8
+		case "numeric":
+			colDef.DataType, colDef.Length, colDef.DecimalPrecision =
+				DECIMAL, int(numPrecision.Int64), int(numScale.Int64)
+		case "real":
+			colDef.DataType = FLOAT
+		case "double precision":
+			colDef.DataType = DOUBLE
+		case "text":
+			colDef.DataType = TEXT
+		case "date":
+			colDef.DataType = DATE
+		case "timestamp without time zone", "timestamp with time zone":
+			colDef.DataType = DATETIME
+		case "boolean":
+			colDef.DataType = BOOLEAN
+		default:
+			return fmt.Errorf("table (%s) column (%s): unsupported data type: %s",
+				tableDef.Name, name, dataType)
+		}
+
+		tableDef.Columns = append(tableDef.Columns, colDef)
+	}
+
+	return rows.Err()
+}
+
+func (meta *PostgreSQLMetaQuery) readKeys(db DbHandlerProxy, schemaName string, tableDef *TableDefinition) error {
+	rows, err := db.Query(
+		"SELECT i.relname, x.indisprimary, x.indisunique, a.attname "+
+			"FROM pg_index x "+
+			"JOIN pg_class t ON t.oid = x.indrelid "+
+			"JOIN pg_class i ON i.oid = x.indexrelid "+
+			"JOIN pg_namespace n ON n.oid = t.relnamespace "+
+			"JOIN LATERAL unnest(x.indkey::int2[]) WITH ORDINALITY AS k(attnum, ord) ON true "+
+			"JOIN pg_attribute a ON a.attrelid = t.oid AND a.attnum = k.attnum "+
+			"WHERE n.nspname = $1 AND t.relname = $2 "+
+			"ORDER BY i.relname, k.ord",
+		schemaName, tableDef.Name)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	keys := &metaKeyCollector{}
+	for rows.Next() {
+		var indexName, columnName string
+		var isPrimary, isUnique bool
+
+		if err := rows.Scan(&indexName, &isPrimary, &isUnique, &columnName); err != nil {
+			return err
+		}
+
+		keys.add(indexName, isPrimary, isUnique, columnName)
+	}
+
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	keys.apply(tableDef, nil)
+
+	return nil
+}
+
+func (meta *PostgreSQLMetaQuery) readForeignKeys(db DbHandlerProxy, schemaName string, tableDef *TableDefinition) error {
+	rows, err := db.Query(
+		"SELECT c.conname, a.attname, rt.relname, ra.attname "+
+			"FROM pg_constraint c "+
+			"JOIN pg_class t ON t.oid = c.conrelid "+
+			"JOIN pg_namespace n ON n.oid = t.relnamespace "+
+			"JOIN pg_class rt ON rt.oid = c.confrelid "+
+			"JOIN LATERAL unnest(c.conkey, c.confkey) WITH ORDINALITY AS k(attnum, refattnum, ord) ON true "+
+			"JOIN pg_attribute a ON a.attrelid = c.conrelid AND a.attnum = k.attnum "+
+			"JOIN pg_attribute ra ON ra.attrelid = c.confrelid AND ra.attnum = k.refattnum "+
+			"WHERE n.nspname = $1 AND t.relname = $2 AND c.contype = 'f' "+
+			"ORDER BY c.conname, k.ord",
+		schemaName, tableDef.Name)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var fkName, columnName, refTableName, refColumnName string
+
+		if err := rows.Scan(&fkName, &columnName, &refTableName, &refColumnName); err != nil {
+			return err
+		}
+
+		appendForeignKeyColumn(tableDef, fkName, refTableName, columnName, refColumnName)
+	}
+
+	return rows.Err()
+}
+
+//GetViewNames get view names of schema which match name pattern
+func (meta *PostgreSQLMetaQuery) GetViewNames(db DbHandlerProxy, schemaName string, viewNamePattern string) ([]string, error) {
+	return queryStrings(db,
+		"SELECT table_name FROM information_schema.views "+
+			"WHERE table_schema = $1 AND table_name LIKE $2 "+
+			"ORDER BY table_name",
+		schemaName, viewNamePattern)
+}
+
+//GetViewDefinition read view definition; view query is parsed into query builder
+func (meta *PostgreSQLMetaQuery) GetViewDefinition(db DbHandlerProxy, schemaName string, viewName string) (*ViewDefinition, error) {
+	var query string
+	err := db.QueryRow(
+		"SELECT view_definition FROM information_schema.views "+
+			"WHERE table_schema = $1 AND table_name = $2",
+		schemaName, viewName).Scan(&query)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("view (%s) not found in schema (%s)", viewName, schemaName)
+	} else if err != nil {
+		return nil, err
+	}
+
+	return newViewDefinitionFromSQL(viewName, query, NewPostgreSQLDialect())
+}
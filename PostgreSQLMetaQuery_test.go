@@ -0,0 +1,79 @@
+package rdbmstool
+
+import (
+	"database/sql/driver"
+	"strings"
+	"testing"
+)
+
+func TestPostgreSQLMetaQuery_GetTableDefinition(t *testing.T) {
+	db, fake := newFakeDB()
+	defer db.Close()
+
+	fake.query = func(query string, args []driver.Value) (*fakeResult, error) {
+		if containsAll(query, "information_schema.columns", "table_schema = $1") &&
+			args[0] == "public" && args[1] == "invoice" {
+			return newFakeResult(
+				[]string{"column_name", "data_type", "character_maximum_length", "numeric_precision",
+					"numeric_scale", "is_nullable", "is_identity", "column_default"},
+				[]driver.Value{"id", "integer", nil, int64(32), int64(0), "NO", "NO", "nextval('invoice_id_seq'::regclass)"},
+				[]driver.Value{"code", "character varying", int64(20), nil, nil, "NO", "NO", ""},
+				[]driver.Value{"amount", "numeric", nil, int64(10), int64(2), "YES", "NO", ""},
+				[]driver.Value{"is_paid", "boolean", nil, nil, nil, "NO", "NO", ""},
+				[]driver.Value{"issue_on", "timestamp without time zone", nil, nil, nil, "NO", "NO", ""},
+				[]driver.Value{"customer_id", "bigint", nil, int64(64), int64(0), "NO", "NO", ""},
+				[]driver.Value{"qty", "smallint", nil, int64(16), int64(0), "NO", "NO", ""},
+			), nil
+		}
+
+		if strings.Contains(query, "pg_index") {
+			return newFakeResult([]string{"relname", "indisprimary", "indisunique", "attname"},
+				[]driver.Value{"invoice_code_key", false, true, "code"},
+				[]driver.Value{"invoice_is_paid_idx", false, false, "is_paid"},
+				[]driver.Value{"invoice_pkey", true, true, "id"},
+			), nil
+		}
+
+		if strings.Contains(query, "pg_constraint") {
+			return newFakeResult([]string{"conname", "attname", "relname", "attname"},
+				[]driver.Value{"fk_invoice_customer", "customer_id", "customer", "id"},
+			), nil
+		}
+
+		return nil, nil
+	}
+
+	var meta MetaQuery = NewPostgreSQLMetaQuery()
+
+	tableDef, err := meta.GetTableDefinition(db, "public", "invoice")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sql, err := tableDef.SQLDialect(NewPostgreSQLDialect())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedSQL := "CREATE TABLE \"invoice\"(\n" +
+		"\"id\" integer GENERATED BY DEFAULT AS IDENTITY NOT NULL,\n" +
+		"\"code\" varchar(20) NOT NULL,\n" +
+		"\"amount\" numeric(10,2) NULL,\n" +
+		"\"is_paid\" boolean NOT NULL,\n" +
+		"\"issue_on\" timestamp NOT NULL,\n" +
+		"\"customer_id\" bigint NOT NULL,\n" +
+		"\"qty\" smallint NOT NULL,\n" +
+		"PRIMARY KEY(\"id\"),\n" +
+		"CONSTRAINT \"invoice_code_key\" UNIQUE (\"code\"),\n" +
+		"CONSTRAINT \"fk_invoice_customer\" FOREIGN KEY (\"customer_id\") REFERENCES \"customer\" (\"id\")\n" +
+		");\n" +
+		"CREATE INDEX \"invoice_is_paid_idx\" ON \"invoice\" (\"is_paid\");"
+
+	if strings.Compare(expectedSQL, sql) != 0 {
+		t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expectedSQL, sql)
+	}
+
+	if _, err := meta.GetTableDefinition(db, "public", "payment"); err == nil {
+		t.Error("Expect error for table not exists")
+	}
+}
@@ -7,6 +7,7 @@ import (
 //QueryBuilder SQl Select statement builder
 type QueryBuilder struct {
 	selectDefinition *SelectDefinition
+	dialect          Dialect
 }
 
 //NewQueryBuilder create new Select SQL string builder
@@ -22,7 +23,19 @@ func NewQueryBuilder() *QueryBuilder {
 			OrderBy: nil,
 			Limit:   nil,
 			Union:   nil,
-		}}
+		},
+		dialect: DefaultDialect()}
+}
+
+//GetSelectDefinition get select definition
+func (builder *QueryBuilder) GetSelectDefinition() *SelectDefinition {
+	return builder.selectDefinition
+}
+
+//Dialect set SQL dialect used to generate SQL string
+func (builder *QueryBuilder) Dialect(dialect Dialect) *QueryBuilder {
+	builder.dialect = dialect
+	return builder
 }
 
 //Select add select column
@@ -34,6 +47,12 @@ func (builder *QueryBuilder) Select(expression string, alias string) *QueryBuild
 	return builder
 }
 
+//Distinct set whether duplicate rows are removed from query result
+func (builder *QueryBuilder) Distinct(isDistinct bool) *QueryBuilder {
+	builder.selectDefinition.Distinct = isDistinct
+	return builder
+}
+
 //SelectClear clear all select columns
 func (builder *QueryBuilder) SelectClear() *QueryBuilder {
 	builder.selectDefinition.Select = nil
@@ -122,6 +141,87 @@ func (builder *QueryBuilder) WhereAddOr(condition string) *QueryBuilder {
 	return builder
 }
 
+//WhereAddAndArgs append AND Where condition with ? placeholder(s) and its bound argument(s)
+func (builder *QueryBuilder) WhereAddAndArgs(condition string, args ...interface{}) *QueryBuilder {
+	if builder.selectDefinition.Where == nil {
+		builder.selectDefinition.Where = NewConditionArgs(condition, args...)
+	} else {
+		builder.selectDefinition.Where.AddAndArgs(condition, args...)
+	}
+
+	return builder
+}
+
+//WhereAddOrArgs append OR Where condition with ? placeholder(s) and its bound argument(s)
+func (builder *QueryBuilder) WhereAddOrArgs(condition string, args ...interface{}) *QueryBuilder {
+	if builder.selectDefinition.Where == nil {
+		builder.selectDefinition.Where = NewConditionArgs(condition, args...)
+	} else {
+		builder.selectDefinition.Where.AddOrArgs(condition, args...)
+	}
+
+	return builder
+}
+
+//WhereEqual append AND "expression = value" Where condition
+func (builder *QueryBuilder) WhereEqual(expression string, value interface{}) *QueryBuilder {
+	return builder.whereAddAnd(NewConditionEqual(expression, value))
+}
+
+//WhereNotEqual append AND "expression <> value" Where condition
+func (builder *QueryBuilder) WhereNotEqual(expression string, value interface{}) *QueryBuilder {
+	return builder.whereAddAnd(NewConditionNotEqual(expression, value))
+}
+
+//WhereGreater append AND "expression > value" Where condition
+func (builder *QueryBuilder) WhereGreater(expression string, value interface{}) *QueryBuilder {
+	return builder.whereAddAnd(NewConditionGreater(expression, value))
+}
+
+//WhereGreaterEqual append AND "expression >= value" Where condition
+func (builder *QueryBuilder) WhereGreaterEqual(expression string, value interface{}) *QueryBuilder {
+	return builder.whereAddAnd(NewConditionGreaterEqual(expression, value))
+}
+
+//WhereLesser append AND "expression < value" Where condition
+func (builder *QueryBuilder) WhereLesser(expression string, value interface{}) *QueryBuilder {
+	return builder.whereAddAnd(NewConditionLesser(expression, value))
+}
+
+//WhereLesserEqual append AND "expression <= value" Where condition
+func (builder *QueryBuilder) WhereLesserEqual(expression string, value interface{}) *QueryBuilder {
+	return builder.whereAddAnd(NewConditionLesserEqual(expression, value))
+}
+
+//WhereLike append AND "expression LIKE pattern" Where condition
+func (builder *QueryBuilder) WhereLike(expression string, pattern interface{}) *QueryBuilder {
+	return builder.whereAddAnd(NewConditionLike(expression, pattern))
+}
+
+//WhereIn append AND "expression IN (values...)" Where condition
+func (builder *QueryBuilder) WhereIn(expression string, values ...interface{}) *QueryBuilder {
+	return builder.whereAddAnd(NewConditionIn(expression, values...))
+}
+
+//WhereBetween append AND "expression BETWEEN from AND to" Where condition
+func (builder *QueryBuilder) WhereBetween(expression string, from interface{}, to interface{}) *QueryBuilder {
+	return builder.whereAddAnd(NewConditionBetween(expression, from, to))
+}
+
+//WhereIsNull append AND "expression IS NULL" Where condition
+func (builder *QueryBuilder) WhereIsNull(expression string) *QueryBuilder {
+	return builder.whereAddAnd(NewConditionIsNull(expression))
+}
+
+//WhereIsNotNull append AND "expression IS NOT NULL" Where condition
+func (builder *QueryBuilder) WhereIsNotNull(expression string) *QueryBuilder {
+	return builder.whereAddAnd(NewConditionIsNotNull(expression))
+}
+
+func (builder *QueryBuilder) whereAddAnd(condition *ConditionDefinition) *QueryBuilder {
+	return builder.WhereAddAndArgs(condition.Condition, condition.Args...)
+}
+
 //WhereComplex set where condition with ConditionDefinition
 func (builder *QueryBuilder) WhereComplex(conditionDef *ConditionDefinition) *QueryBuilder {
 	builder.selectDefinition.Where = conditionDef
@@ -236,7 +336,18 @@ func (builder *QueryBuilder) UnionClear() *QueryBuilder {
 	return builder
 }
 
+//Validate check query definition integrity
+func (builder *QueryBuilder) Validate() error {
+	return builder.selectDefinition.Validate()
+}
+
 //SQL generate SQL string
 func (builder *QueryBuilder) SQL() (string, error) {
-	return builder.selectDefinition.SQL()
+	return builder.selectDefinition.SQLDialect(builder.dialect)
+}
+
+//Build generate SQL string with dialect placeholders and its bound arguments;
+//output is ready to pass into DbHandlerProxy.Query(sql, args...)
+func (builder *QueryBuilder) Build() (string, []interface{}, error) {
+	return builder.selectDefinition.Build(builder.dialect)
 }
@@ -39,3 +39,169 @@ LIMIT 20 OFFSET 5`
 		t.Errorf("Generate SQL not match with expected SQL\n\nExpected:\n%s\n\nActual:\n%s", expectedSQL, sql)
 	}
 }
+
+func TestQueryBuilder_JoinSource(t *testing.T) {
+	subQuery := NewQueryBuilder().
+		Select("c.member_id", "").
+		Select("SUM(c.amount)", "total").
+		From("invoice", "c").
+		GroupBy("c.member_id", true)
+
+	builder := NewQueryBuilder().
+		Select("a.name", "").
+		Select("b.name", "school").
+		Select("d.total", "").
+		From("student", "a").
+		Join("school", "b", RightJoin, "a.school = b.name").
+		JoinComplexAdd(NewJoinDefinitionComplex(subQuery.GetSelectDefinition(), "d",
+			LeftJoin, NewCondition("d.member_id = a.id")))
+
+	sql, err := builder.SQL()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	//RIGHT JOIN keyword is kept and sub-query source is parenthesized
+	expectedSQL := "SELECT a.name, b.name AS school, d.total\n" +
+		"FROM student AS a\n" +
+		"RIGHT JOIN school AS b ON a.school = b.name\n" +
+		"LEFT JOIN (SELECT c.member_id, SUM(c.amount) AS total\n" +
+		"FROM invoice AS c\n" +
+		"GROUP BY c.member_id) AS d ON d.member_id = a.id"
+	if strings.Compare(expectedSQL, sql) != 0 {
+		t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expectedSQL, sql)
+	}
+
+	if _, err := ParseSelectDefinition(sql); err != nil {
+		t.Errorf("Expect generated SQL can be parsed but get: %s", err.Error())
+	}
+}
+
+func TestQueryBuilder_Distinct(t *testing.T) {
+	sql, err := NewQueryBuilder().Distinct(true).Select("a", "").Select("b", "").From("t", "").SQL()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedSQL := "SELECT DISTINCT a, b\nFROM t"
+	if strings.Compare(expectedSQL, sql) != 0 {
+		t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expectedSQL, sql)
+	}
+}
+
+func TestQueryBuilder_Build(t *testing.T) {
+	builder := NewQueryBuilder().
+		Select("a.name", "").
+		From("student", "a").
+		WhereEqual("a.state", "open").
+		WhereLike("a.name", "%john%").
+		WhereIn("a.grade", 1, 2, 3).
+		WhereBetween("a.age", 7, 12).
+		WhereIsNotNull("a.school").
+		WhereAddOrArgs("a.remark = 'what?' OR a.nick = ?", "jo").
+		GroupBy("a.name", true).
+		HavingComplex(NewConditionArgs("COUNT(a.id) > ?", 3))
+
+	sql, args, err := builder.Dialect(NewPostgreSQLDialect()).Build()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	expectedSQL := `SELECT a.name
+FROM student AS a
+WHERE a.state = $1 AND a.name LIKE $2 AND a.grade IN ($3, $4, $5) AND a.age BETWEEN $6 AND $7 AND a.school IS NOT NULL OR a.remark = 'what?' OR a.nick = $8
+GROUP BY a.name
+HAVING COUNT(a.id) > $9`
+
+	if strings.Compare(expectedSQL, sql) != 0 {
+		t.Errorf("Generate SQL not match with expected SQL\n\nExpected:\n%s\n\nActual:\n%s", expectedSQL, sql)
+	}
+
+	expectedArgs := []interface{}{"open", "%john%", 1, 2, 3, 7, 12, "jo", 3}
+	if len(args) != len(expectedArgs) {
+		t.Errorf("expect %d arguments but get %d instead", len(expectedArgs), len(args))
+		return
+	}
+	for i := range args {
+		if args[i] != expectedArgs[i] {
+			t.Errorf("expect argument %d is %v but get %v instead", i, expectedArgs[i], args[i])
+		}
+	}
+
+	sql, _, err = builder.Dialect(NewMySQLDialect()).Build()
+	if err != nil {
+		t.Error(err)
+	} else if strings.Contains(sql, "$") || strings.Count(sql, "?") != 10 {
+		t.Errorf("expect MySQL placeholders but get:\n%s", sql)
+	}
+
+	if _, _, err := NewQueryBuilder().Select("a", "").From("b", "").
+		WhereAddAndArgs("a = ? AND b = ?", 1).Build(); err == nil {
+		t.Errorf("expect error since placeholders and arguments quantity not tally")
+	}
+
+	if _, err := builder.SQL(); err == nil {
+		t.Errorf("expect error since SQL string without its bound arguments is not executable")
+	}
+
+	if _, err := NewInsertBuilder().Into("a").Columns("b").Values(1).SQL(); err == nil {
+		t.Errorf("expect error since INSERT value is bound as argument")
+	}
+
+	sql, err = NewInsertBuilder().Into("a").Columns("b").Values(Expression("NULL")).SQL()
+	if err != nil {
+		t.Error(err)
+	} else if strings.Compare("INSERT INTO a (b)\nVALUES (NULL)", sql) != 0 {
+		t.Errorf("Expect INSERT without bound argument but get:\n%s", sql)
+	}
+}
+
+func TestQueryBuilder_Validate(t *testing.T) {
+	builder := NewQueryBuilder().
+		Select("a.name", "").
+		JoinComplexAdd(&JoinDefinition{source: "school", Alias: "b", Type: InnerJoin}).
+		WhereAddAndArgs("a.age > ? AND a.age < ?", 7)
+
+	err := builder.Validate()
+	validationErr, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("expect *ValidationError but get %v", err)
+	}
+
+	expectedProblems := []string{
+		"from: query must have FROM source",
+		"join[0].on: JOIN condition cannot be empty",
+		"where: expression (a.age > ? AND a.age < ?) has more placeholders than 1 argument(s)",
+	}
+
+	if len(validationErr.Problems) != len(expectedProblems) {
+		t.Fatalf("expect %d problems but get:\n%s", len(expectedProblems), err.Error())
+	}
+
+	for index, problem := range validationErr.Problems {
+		if strings.Compare(expectedProblems[index], problem.String()) != 0 {
+			t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expectedProblems[index], problem.String())
+		}
+	}
+}
+
+//expectValidationProblems check err is *ValidationError with expected problems in order
+func expectValidationProblems(t *testing.T, err error, expectedProblems []string) {
+	validationErr, ok := err.(*ValidationError)
+	if !ok {
+		t.Errorf("expect *ValidationError but get %v", err)
+		return
+	}
+
+	if len(validationErr.Problems) != len(expectedProblems) {
+		t.Errorf("expect %d problems but get:\n%s", len(expectedProblems), err.Error())
+		return
+	}
+
+	for index, problem := range validationErr.Problems {
+		if strings.Compare(expectedProblems[index], problem.String()) != 0 {
+			t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expectedProblems[index], problem.String())
+		}
+	}
+}
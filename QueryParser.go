@@ -0,0 +1,326 @@
+package rdbmstool
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/guinso/rdbmstool/parser"
+)
+
+//ParseSelectDefinition parse SELECT statement into select definition with default dialect
+func ParseSelectDefinition(sql string) (*SelectDefinition, error) {
+	return ParseSelectDefinitionDialect(sql, DefaultDialect())
+}
+
+//ParseSelectDefinitionDialect parse SELECT statement written in specified dialect into
+//select definition
+func ParseSelectDefinitionDialect(sql string, dialect Dialect) (*SelectDefinition, error) {
+	ast, err := parseSQL(sql, dialect)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSelectDefinitionFromSyntaxTree(ast)
+}
+
+//parseSQL parse SQL statement with quoting rule of dialect
+func parseSQL(sql string, dialect Dialect) (*parser.SyntaxTree, error) {
+	if resolveDialect(dialect).AnsiQuotes() {
+		return parser.ParseSQLAnsiQuotes(sql)
+	}
+
+	return parser.ParseSQL(sql)
+}
+
+//NewQueryBuilderFromSQL parse SELECT statement into query builder so that it can be
+//modified and regenerated
+func NewQueryBuilderFromSQL(sql string) (*QueryBuilder, error) {
+	query, err := ParseSelectDefinition(sql)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := NewQueryBuilder()
+	builder.selectDefinition = query
+
+	return builder, nil
+}
+
+//NewSelectDefinitionFromSyntaxTree convert parsed query (NodeQuery or NodeQuerySelect)
+//into select definition; expressions are kept as SQL text
+func NewSelectDefinitionFromSyntaxTree(ast *parser.SyntaxTree) (*SelectDefinition, error) {
+	switch ast.DataType {
+	case parser.NodeQuerySelect:
+		return convertQuerySelect(ast)
+	case parser.NodeQuery:
+		if len(ast.ChildNodes) == 0 {
+			return nil, fmt.Errorf("query syntax tree has no SELECT statement")
+		}
+
+		query, err := convertQuerySelect(&ast.ChildNodes[0])
+		if err != nil {
+			return nil, err
+		}
+
+		for index := 1; index < len(ast.ChildNodes); index++ {
+			union, err := convertQuerySelect(&ast.ChildNodes[index])
+			if err != nil {
+				return nil, fmt.Errorf("union (index %d): %s", index-1, err.Error())
+			}
+
+			query.Union = append(query.Union, *union)
+		}
+
+		return query, nil
+	default:
+		return nil, fmt.Errorf("syntax tree node type (%d) is not a query", ast.DataType)
+	}
+}
+
+func convertQuerySelect(ast *parser.SyntaxTree) (*SelectDefinition, error) {
+	if ast.DataType != parser.NodeQuerySelect {
+		return nil, fmt.Errorf("syntax tree node type (%d) is not a SELECT query", ast.DataType)
+	}
+
+	query := &SelectDefinition{}
+
+	for index := range ast.ChildNodes {
+		node := &ast.ChildNodes[index]
+
+		switch node.DataType {
+		case parser.NodeSelect:
+			for _, col := range node.ChildNodes {
+				if col.DataType == parser.NodeDistinct {
+					query.Distinct = true
+					continue
+				}
+
+				query.Select = append(query.Select, SelectColumnDefinition{
+					Expression: col.ChildNodes[0].Text(),
+					Alias:      aliasName(&col)})
+			}
+		case parser.NodeFrom:
+			from, err := convertFrom(node)
+			if err != nil {
+				return nil, err
+			}
+			query.From = from
+		case parser.NodeJoin:
+			join, err := convertJoin(node)
+			if err != nil {
+				return nil, err
+			}
+			query.Join = append(query.Join, *join)
+		case parser.NodeWhere:
+			where, err := convertCondition(&node.ChildNodes[0])
+			if err != nil {
+				return nil, err
+			}
+			query.Where = where
+		case parser.NodeGroupBy:
+			for _, col := range node.ChildNodes {
+				expression, isAscending := orderColumn(&col)
+				query.GroupBy = append(query.GroupBy, GroupByDefinition{
+					Expression: expression,
+					IsAcending: isAscending})
+			}
+		case parser.NodeHaving:
+			having, err := convertCondition(&node.ChildNodes[0])
+			if err != nil {
+				return nil, err
+			}
+			query.Having = having
+		case parser.NodeOrderBy:
+			for _, col := range node.ChildNodes {
+				expression, isAscending := orderColumn(&col)
+				query.OrderBy = append(query.OrderBy, OrderByDefinition{
+					Expression:  expression,
+					IsAscending: isAscending})
+			}
+		case parser.NodeLimit:
+			limit, err := convertLimit(node)
+			if err != nil {
+				return nil, err
+			}
+			query.Limit = limit
+		default:
+			return nil, fmt.Errorf("unsupported query clause node type (%d)", node.DataType)
+		}
+	}
+
+	return query, nil
+}
+
+//aliasName get alias name from last child node if it is NodeAlias; alias token
+//always located at end of alias node
+func aliasName(ast *parser.SyntaxTree) string {
+	if len(ast.ChildNodes) < 2 {
+		return ""
+	}
+
+	alias := &ast.ChildNodes[len(ast.ChildNodes)-1]
+	if alias.DataType != parser.NodeAlias {
+		return ""
+	}
+
+	return ast.Source[alias.EndPosition].Value
+}
+
+//convertSource convert NodeSource into expression text or sub-query
+func convertSource(ast *parser.SyntaxTree) (string, *SelectDefinition, error) {
+	if len(ast.ChildNodes) == 0 {
+		return ast.Text(), nil, nil
+	}
+
+	subQuery, err := NewSelectDefinitionFromSyntaxTree(&ast.ChildNodes[0])
+	if err != nil {
+		return "", nil, fmt.Errorf("sub-query: %s", err.Error())
+	}
+
+	return "", subQuery, nil
+}
+
+func convertFrom(ast *parser.SyntaxTree) (*FromDefinition, error) {
+	expression, subQuery, err := convertSource(&ast.ChildNodes[0])
+	if err != nil {
+		return nil, fmt.Errorf("FROM %s", err.Error())
+	}
+
+	if subQuery != nil {
+		return NewFromDefinitionSubQuery(subQuery, aliasName(ast)), nil
+	}
+
+	return NewFromDefinition(expression, aliasName(ast)), nil
+}
+
+func convertJoin(ast *parser.SyntaxTree) (*JoinDefinition, error) {
+	join := &JoinDefinition{}
+
+	switch ast.Source[ast.StartPosition].Type {
+	case parser.TokenJoin:
+		join.Type = Join
+	case parser.TokenInnerJoin:
+		join.Type = InnerJoin
+	case parser.TokenOuterJoin:
+		join.Type = OuterJoin
+	case parser.TokenLeftJoin:
+		join.Type = LeftJoin
+	case parser.TokenRightJoin:
+		join.Type = RightJoin
+	default:
+		return nil, fmt.Errorf("unsupported JOIN token: %s", ast.Source[ast.StartPosition].Value)
+	}
+
+	source, subQuery, err := convertSource(&ast.ChildNodes[0])
+	if err != nil {
+		return nil, fmt.Errorf("JOIN %s", err.Error())
+	}
+	join.source = source
+	join.subQuery = subQuery
+
+	for index := 1; index < len(ast.ChildNodes); index++ {
+		node := &ast.ChildNodes[index]
+
+		if node.DataType == parser.NodeAlias {
+			join.Alias = ast.Source[node.EndPosition].Value
+		} else if node.DataType == parser.NodeCondition {
+			condition, err := convertCondition(node)
+			if err != nil {
+				return nil, fmt.Errorf("JOIN condition: %s", err.Error())
+			}
+			join.Where = condition
+		}
+	}
+
+	return join, nil
+}
+
+//convertCondition convert NodeCondition (<expr> AND|OR <expr> ...) into condition definition;
+//parenthesized sub condition become nested condition
+func convertCondition(ast *parser.SyntaxTree) (*ConditionDefinition, error) {
+	if ast.DataType != parser.NodeCondition {
+		return NewCondition(ast.Text()), nil
+	}
+
+	if len(ast.ChildNodes) == 0 {
+		return nil, fmt.Errorf("condition is empty")
+	}
+
+	result := &ConditionDefinition{Operator: None, Conditions: []ConditionDefinition{}}
+	operator := None
+
+	for index := range ast.ChildNodes {
+		node := &ast.ChildNodes[index]
+
+		if node.DataType == parser.NodeOperator {
+			switch node.Source[node.StartPosition].Type {
+			case parser.TokenAnd:
+				operator = And
+			case parser.TokenOr:
+				operator = Or
+			default:
+				return nil, fmt.Errorf("unsupported condition operator: %s", node.Source[node.StartPosition].Value)
+			}
+			continue
+		}
+
+		item := ConditionDefinition{Operator: operator}
+		if node.DataType == parser.NodeCondition {
+			subCondition, err := convertCondition(node)
+			if err != nil {
+				return nil, err
+			}
+			item.ConditionComplex = subCondition
+		} else {
+			item.Condition = node.Text()
+		}
+
+		if index == 0 {
+			result.Condition = item.Condition
+			result.ConditionComplex = item.ConditionComplex
+		} else {
+			result.Conditions = append(result.Conditions, item)
+		}
+	}
+
+	return result, nil
+}
+
+//orderColumn get expression and sort order of GROUP BY / ORDER BY column node
+func orderColumn(ast *parser.SyntaxTree) (string, bool) {
+	expression := ast.ChildNodes[0].Text()
+
+	if len(ast.ChildNodes) > 1 {
+		order := &ast.ChildNodes[1]
+		return expression, order.Source[order.StartPosition].Type != parser.TokenDesc
+	}
+
+	return expression, true
+}
+
+//convertLimit convert LIMIT n, LIMIT n OFFSET m, or LIMIT m, n into limit definition
+func convertLimit(ast *parser.SyntaxTree) (*LimitDefinition, error) {
+	numbers := []int{}
+	for index := ast.StartPosition; index <= ast.EndPosition; index++ {
+		if ast.Source[index].Type == parser.TokenNumber {
+			number, err := strconv.Atoi(ast.Source[index].Value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid LIMIT value: %s", ast.Source[index].Value)
+			}
+
+			numbers = append(numbers, number)
+		}
+	}
+
+	switch {
+	case len(numbers) == 1:
+		return NewLimitDefinition(numbers[0], 0), nil
+	case len(numbers) == 2 && ast.Source[ast.StartPosition+2].Type == parser.TokenOffset:
+		return NewLimitDefinition(numbers[0], numbers[1]), nil
+	case len(numbers) == 2:
+		//MySQL style: LIMIT offset, row_count
+		return NewLimitDefinition(numbers[1], numbers[0]), nil
+	default:
+		return nil, fmt.Errorf("invalid LIMIT syntax")
+	}
+}
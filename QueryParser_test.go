@@ -0,0 +1,155 @@
+package rdbmstool
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewQueryBuilderFromSQL(t *testing.T) {
+	builder, err := NewQueryBuilderFromSQL("SELECT a.order_id, b.name AS customer, SUM(a.amount) total " +
+		"FROM invoice a " +
+		"LEFT JOIN customer b ON a.customer_id = b.id AND (b.is_active = 1 OR b.is_vip = 1) " +
+		"JOIN (SELECT id, name FROM agent WHERE is_active = 1) c ON c.id = b.agent_id " +
+		"WHERE a.amount > -100 AND a.status = ? " +
+		"GROUP BY a.order_id, b.name " +
+		"HAVING SUM(a.amount) > 1000 " +
+		"ORDER BY b.name DESC, a.order_id " +
+		"LIMIT 20, 10 " +
+		"UNION " +
+		"SELECT x.order_id, x.name, x.total FROM archive.invoice AS x;")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	//tenant filter and paging
+	builder.WhereAddAndArgs("a.tenant_id = ?", 7).Limit(50, 0)
+
+	expectedSQL := "SELECT a.order_id, b.name AS customer, SUM(a.amount) AS total\n" +
+		"FROM invoice AS a\n" +
+		"LEFT JOIN customer AS b ON a.customer_id = b.id AND (b.is_active = 1 OR b.is_vip = 1)\n" +
+		"JOIN (SELECT id, name\nFROM agent\nWHERE is_active = 1) AS c ON c.id = b.agent_id\n" +
+		"WHERE a.amount > -100 AND a.status = ? AND a.tenant_id = ?\n" +
+		"GROUP BY a.order_id, b.name\n" +
+		"HAVING SUM(a.amount) > 1000\n" +
+		"ORDER BY b.name DESC, a.order_id\n" +
+		"LIMIT 50 OFFSET 0\n" +
+		"UNION\n" +
+		"SELECT x.order_id, x.name, x.total\n" +
+		"FROM archive.invoice AS x"
+
+	sql, args, err := builder.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Compare(expectedSQL, sql) != 0 {
+		t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expectedSQL, sql)
+	}
+
+	if len(args) != 1 || args[0] != 7 {
+		t.Errorf("Expect bound arguments [7] but get %v", args)
+	}
+
+	//regenerated SQL can be parsed again
+	if _, err := ParseSelectDefinition(sql); err != nil {
+		t.Errorf("Expect regenerated SQL can be parsed but get error: %s", err.Error())
+	}
+
+	if _, err := NewQueryBuilderFromSQL("SELECT a FROM b WHERE"); err == nil {
+		t.Error("Expect syntax error")
+	}
+}
+
+func TestNewQueryBuilderFromSQL_orderByFunction(t *testing.T) {
+	query, err := ParseSelectDefinition(
+		"SELECT LOWER(a.name) AS name, COUNT(*) AS total FROM member a " +
+			"GROUP BY LOWER(a.name) ORDER BY COUNT(*) DESC, name")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(query.GroupBy) != 1 || strings.Compare(query.GroupBy[0].Expression, "LOWER(a.name)") != 0 {
+		t.Errorf("Expect GROUP BY LOWER(a.name) but get %v", query.GroupBy)
+	}
+
+	if len(query.OrderBy) != 2 ||
+		strings.Compare(query.OrderBy[0].Expression, "COUNT(*)") != 0 || query.OrderBy[0].IsAscending ||
+		strings.Compare(query.OrderBy[1].Expression, "name") != 0 || !query.OrderBy[1].IsAscending {
+		t.Errorf("Expect ORDER BY COUNT(*) DESC, name but get %v", query.OrderBy)
+	}
+}
+
+func TestNewQueryBuilderFromSQL_distinct(t *testing.T) {
+	sqls := map[string]string{
+		"SELECT DISTINCT a FROM t":           "SELECT DISTINCT a\nFROM t",
+		"select distinct a, b AS c FROM t":   "SELECT DISTINCT a, b AS c\nFROM t",
+		"SELECT COUNT(DISTINCT a) n FROM t":  "SELECT COUNT(DISTINCT a) AS n\nFROM t",
+		"SELECT DISTINCT COUNT(*), b FROM t": "SELECT DISTINCT COUNT(*), b\nFROM t"}
+
+	for sql, expectedSQL := range sqls {
+		builder, err := NewQueryBuilderFromSQL(sql)
+		if err != nil {
+			t.Errorf("%s: %s", sql, err.Error())
+			continue
+		}
+
+		result, err := builder.SQL()
+		if err != nil {
+			t.Errorf("%s: %s", sql, err.Error())
+			continue
+		}
+
+		if strings.Compare(expectedSQL, result) != 0 {
+			t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expectedSQL, result)
+		}
+	}
+
+	query, err := ParseSelectDefinition("SELECT DISTINCT a, b FROM t")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !query.Distinct || len(query.Select) != 2 {
+		t.Errorf("Expect DISTINCT query with 2 columns but get distinct %t with %d columns",
+			query.Distinct, len(query.Select))
+	}
+}
+
+func TestParseSelectDefinitionDialect_doubleQuote(t *testing.T) {
+	//MySQL: double quoted text is string
+	query, err := ParseSelectDefinition("SELECT a.name FROM member a WHERE a.status = \"active\"")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sql, err := query.SQLDialect(NewMySQLDialect())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedSQL := "SELECT a.name\nFROM member AS a\nWHERE a.status = \"active\""
+	if strings.Compare(expectedSQL, sql) != 0 {
+		t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expectedSQL, sql)
+	}
+
+	//PostgreSQL: double quoted text is identifier
+	query, err = ParseSelectDefinitionDialect(
+		"SELECT \"a\".\"name\" FROM \"member\" \"a\" WHERE \"a\".\"status\" = 'active'", NewPostgreSQLDialect())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sql, err = query.SQLDialect(NewPostgreSQLDialect())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedSQL = "SELECT \"a\".\"name\"\nFROM \"member\" AS \"a\"\nWHERE \"a\".\"status\" = 'active'"
+	if strings.Compare(expectedSQL, sql) != 0 {
+		t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expectedSQL, sql)
+	}
+
+	if _, err := ParseSelectDefinition("SELECT \"a\".\"name\" FROM \"member\" \"a\""); err == nil {
+		t.Error("Expect syntax error for double quoted identifier in MySQL")
+	}
+}
@@ -0,0 +1,207 @@
+package rdbmstool
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+//SQLServerDialect Microsoft SQL Server (T-SQL) dialect
+type SQLServerDialect struct{}
+
+//NewSQLServerDialect create SQL Server dialect
+func NewSQLServerDialect() *SQLServerDialect {
+	return &SQLServerDialect{}
+}
+
+//Name dialect name
+func (dialect *SQLServerDialect) Name() string {
+	return "sqlserver"
+}
+
+//QuoteIdentifier quote identifier with square bracket
+func (dialect *SQLServerDialect) QuoteIdentifier(identifier string) string {
+	return "[" + strings.Replace(identifier, "]", "]]", -1) + "]"
+}
+
+//AnsiQuotes double quoted text is identifier (QUOTED_IDENTIFIER ON)
+func (dialect *SQLServerDialect) AnsiQuotes() bool {
+	return true
+}
+
+//ColumnType generate T-SQL data column type SQL string
+func (dialect *SQLServerDialect) ColumnType(colDef *ColumnDefinition) (string, error) {
+	switch colDef.DataType {
+	case CHAR:
+		return fmt.Sprintf("NCHAR(%d)", colDef.Length), nil
+	case INTEGER:
+		sqlType := sqlServerIntegerType(columnIntegerSize(colDef))
+		if colDef.IsAutoIncrement {
+			return sqlType + " IDENTITY(1,1)", nil
+		}
+		return sqlType, nil
+	case DECIMAL:
+		return fmt.Sprintf("DECIMAL(%d,%d)", colDef.Length, colDef.DecimalPrecision), nil
+	case FLOAT:
+		return "REAL", nil
+	case DOUBLE:
+		return "FLOAT", nil
+	case TEXT:
+		return "NVARCHAR(MAX)", nil
+	case DATE:
+		return "DATE", nil
+	case DATETIME:
+		return "DATETIME2", nil
+	case BOOLEAN:
+		return "BIT", nil
+	case VARCHAR:
+		return fmt.Sprintf("NVARCHAR(%d)", colDef.Length), nil
+	default:
+		return "", fmt.Errorf(
+			"unknown data column (%s) type: %d", colDef.Name, colDef.DataType)
+	}
+}
+
+//sqlServerIntegerType get smallest T-SQL integer type which fit integer size;
+//TINYINT is not used since it is unsigned in SQL Server
+func sqlServerIntegerType(size int) string {
+	switch size {
+	case 1, 2:
+		return "SMALLINT"
+	case 8:
+		return "BIGINT"
+	default:
+		return "INT"
+	}
+}
+
+//TableOptions SQL Server has no table options
+func (dialect *SQLServerDialect) TableOptions() string {
+	return ""
+}
+
+//InlinePrimaryKey primary key always declared with PRIMARY KEY clause
+func (dialect *SQLServerDialect) InlinePrimaryKey(tableDef *TableDefinition) bool {
+	return false
+}
+
+//UniqueKey generate named UNIQUE constraint clause
+func (dialect *SQLServerDialect) UniqueKey(tableName string, columnNames []string) string {
+	return fmt.Sprintf("CONSTRAINT %s UNIQUE (%s)",
+		dialect.QuoteIdentifier(uniqueKeyName(tableName, columnNames)),
+		quoteIdentifiers(dialect, columnNames))
+}
+
+//InlineIndex SQL Server index key is created by separate CREATE INDEX statement
+func (dialect *SQLServerDialect) InlineIndex() bool {
+	return false
+}
+
+//IndexKey generate CREATE INDEX statement
+func (dialect *SQLServerDialect) IndexKey(tableName string, columnNames []string) string {
+	return fmt.Sprintf("CREATE INDEX %s ON %s (%s);",
+		dialect.QuoteIdentifier(indexKeyName(tableName, columnNames)),
+		dialect.QuoteIdentifier(tableName),
+		quoteIdentifiers(dialect, columnNames))
+}
+
+//Limit generate OFFSET ... ROWS FETCH NEXT ... ROWS ONLY SQL string
+//NOTE: it must be placed after ORDER BY clause
+func (dialect *SQLServerDialect) Limit(limit *LimitDefinition) (string, error) {
+	return fmt.Sprintf("OFFSET %d ROWS FETCH NEXT %d ROWS ONLY", limit.Offset, limit.RowCount), nil
+}
+
+//ValidateQuery SQL Server pagination require ORDER BY clause
+func (dialect *SQLServerDialect) ValidateQuery(query *SelectDefinition) error {
+	if query.Limit != nil && len(query.OrderBy) == 0 {
+		return errors.New("SQL Server pagination (OFFSET ... FETCH) require ORDER BY clause")
+	}
+
+	return nil
+}
+
+//Placeholder generate named placeholder (@p1, @p2, ...)
+func (dialect *SQLServerDialect) Placeholder(index int) string {
+	return fmt.Sprintf("@p%d", index)
+}
+
+//UpdateJoin join other table(s) with UPDATE alias SET ... FROM ... JOIN syntax
+func (dialect *SQLServerDialect) UpdateJoin() UpdateJoinStyle {
+	return UpdateJoinFromTarget
+}
+
+//DeleteJoin join other table(s) with DELETE alias FROM ... JOIN syntax
+func (dialect *SQLServerDialect) DeleteJoin() DeleteJoinStyle {
+	return DeleteJoinTarget
+}
+
+//DeleteLimit DELETE ... LIMIT n is not supported
+func (dialect *SQLServerDialect) DeleteLimit() bool {
+	return false
+}
+
+//Upsert insert or update row with MERGE statement
+func (dialect *SQLServerDialect) Upsert() UpsertStyle {
+	return UpsertMerge
+}
+
+//AlterTable generate T-SQL ALTER TABLE, CREATE INDEX, or DROP INDEX statement
+func (dialect *SQLServerDialect) AlterTable(tableName string, operation *AlterTableOperation) (string, error) {
+	prefix := "ALTER TABLE " + dialect.QuoteIdentifier(tableName) + " "
+
+	switch operation.Type {
+	case AddColumn:
+		colSQL, err := columnSQL(dialect, operation.Column)
+		if err != nil {
+			return "", err
+		}
+		return prefix + "ADD " + colSQL + ";", nil
+	case ModifyColumn:
+		if operation.OldColumn.IsAutoIncrement != operation.Column.IsAutoIncrement {
+			return "", fmt.Errorf("SQL Server cannot change IDENTITY property of column (%s)",
+				operation.Column.Name)
+		}
+
+		baseCol := *operation.Column
+		baseCol.IsAutoIncrement = false
+		colSQL, err := columnSQL(dialect, &baseCol)
+		if err != nil {
+			return "", err
+		}
+		return prefix + "ALTER COLUMN " + colSQL + ";", nil
+	case DropColumn:
+		return prefix + "DROP COLUMN " + dialect.QuoteIdentifier(operation.Column.Name) + ";", nil
+	case AddPrimaryKey:
+		return prefix + "ADD PRIMARY KEY(" + quoteIdentifiers(dialect, operation.ColumnNames) + ");", nil
+	case DropPrimaryKey:
+		return "", fmt.Errorf("SQL Server primary key constraint name of table (%s) is generated by "+
+			"database; primary key must be dropped manually", tableName)
+	case AddUniqueKey:
+		return prefix + "ADD " + dialect.UniqueKey(tableName, operation.ColumnNames) + ";", nil
+	case DropUniqueKey:
+		return prefix + "DROP CONSTRAINT " +
+			dialect.QuoteIdentifier(uniqueKeyName(tableName, operation.ColumnNames)) + ";", nil
+	case AddIndexKey:
+		return dialect.IndexKey(tableName, operation.ColumnNames), nil
+	case DropIndexKey:
+		return "DROP INDEX " + dialect.QuoteIdentifier(indexKeyName(tableName, operation.ColumnNames)) +
+			" ON " + dialect.QuoteIdentifier(tableName) + ";", nil
+	case AddForeignKey:
+		return prefix + "ADD " + foreignKeySQL(dialect, operation.ForeignKeyName, operation.ForeignKey) + ";", nil
+	case DropForeignKey:
+		return prefix + "DROP CONSTRAINT " + dialect.QuoteIdentifier(operation.ForeignKeyName) + ";", nil
+	default:
+		return "", fmt.Errorf("unknown ALTER TABLE operation type: %d", operation.Type)
+	}
+}
+
+//TransactionalDDL SQL Server DDL statement can be rolled back
+func (dialect *SQLServerDialect) TransactionalDDL() bool {
+	return true
+}
+
+//TableExistsQuery count data table from INFORMATION_SCHEMA of current database
+func (dialect *SQLServerDialect) TableExistsQuery() string {
+	return "SELECT COUNT(*) FROM INFORMATION_SCHEMA.TABLES " +
+		"WHERE TABLE_TYPE = 'BASE TABLE' AND TABLE_NAME = @p1"
+}
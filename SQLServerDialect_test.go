@@ -0,0 +1,69 @@
+package rdbmstool
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSQLServerDialect_TableSQL(t *testing.T) {
+	builder := NewTableBuilder().
+		Dialect(NewSQLServerDialect()).
+		TableName("member").
+		AddColumnIntAutoIncrement("id", 10).
+		AddColumnInt("member_no", 20, false).
+		AddColumnInt("level", 4, true).
+		AddColumnVarchar("name", 100, false).
+		AddColumnText("remark", true).
+		AddColumnBoolean("is_vip", false).
+		AddColumnDateTime("last_access", true).
+		AddPrimaryKey("id").
+		AddIndexKey("name")
+
+	expectedSQL := "CREATE TABLE [member](\n" +
+		"[id] INT IDENTITY(1,1) NOT NULL,\n" +
+		"[member_no] BIGINT NOT NULL,\n" +
+		"[level] SMALLINT NULL,\n" +
+		"[name] NVARCHAR(100) NOT NULL,\n" +
+		"[remark] NVARCHAR(MAX) NULL,\n" +
+		"[is_vip] BIT NOT NULL,\n" +
+		"[last_access] DATETIME2 NULL,\n" +
+		"PRIMARY KEY([id])\n" +
+		");\n" +
+		"CREATE INDEX [member_name_idx] ON [member] ([name]);"
+
+	sql, err := builder.SQL()
+	if err != nil {
+		t.Error(err)
+	}
+
+	if strings.Compare(expectedSQL, sql) != 0 {
+		t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expectedSQL, sql)
+	}
+}
+
+func TestSQLServerDialect_QuerySQL(t *testing.T) {
+	builder := NewQueryBuilder().
+		Dialect(NewSQLServerDialect()).
+		Select("a.name", "").
+		From("member", "a").
+		OrderBy("a.name", true).
+		Limit(10, 20)
+
+	expectedSQL := "SELECT a.name\n" +
+		"FROM member AS a\n" +
+		"ORDER BY a.name\n" +
+		"OFFSET 20 ROWS FETCH NEXT 10 ROWS ONLY"
+
+	sql, err := builder.SQL()
+	if err != nil {
+		t.Error(err)
+	}
+
+	if strings.Compare(expectedSQL, sql) != 0 {
+		t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expectedSQL, sql)
+	}
+
+	if _, err := builder.OrderByClear().SQL(); err == nil {
+		t.Errorf("expect error since pagination without ORDER BY is not supported")
+	}
+}
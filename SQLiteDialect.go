@@ -0,0 +1,157 @@
+package rdbmstool
+
+import (
+	"fmt"
+	"strings"
+)
+
+//SQLiteDialect SQLite SQL dialect
+type SQLiteDialect struct{}
+
+//NewSQLiteDialect create SQLite dialect
+func NewSQLiteDialect() *SQLiteDialect {
+	return &SQLiteDialect{}
+}
+
+//Name dialect name
+func (dialect *SQLiteDialect) Name() string {
+	return "sqlite"
+}
+
+//QuoteIdentifier quote identifier with double quote
+func (dialect *SQLiteDialect) QuoteIdentifier(identifier string) string {
+	return "\"" + strings.Replace(identifier, "\"", "\"\"", -1) + "\""
+}
+
+//AnsiQuotes double quoted text is identifier
+func (dialect *SQLiteDialect) AnsiQuotes() bool {
+	return true
+}
+
+//ColumnType generate SQLite type affinity (INTEGER, REAL, NUMERIC, TEXT)
+//NOTE: auto increment is achieved by INTEGER PRIMARY KEY (rowid alias)
+func (dialect *SQLiteDialect) ColumnType(colDef *ColumnDefinition) (string, error) {
+	switch colDef.DataType {
+	case INTEGER, BOOLEAN:
+		return "INTEGER", nil
+	case DECIMAL:
+		return "NUMERIC", nil
+	case FLOAT, DOUBLE:
+		return "REAL", nil
+	case CHAR, VARCHAR, TEXT, DATE, DATETIME:
+		return "TEXT", nil
+	default:
+		return "", fmt.Errorf(
+			"unknown data column (%s) type: %d", colDef.Name, colDef.DataType)
+	}
+}
+
+//TableOptions SQLite has no table options
+func (dialect *SQLiteDialect) TableOptions() string {
+	return ""
+}
+
+//InlinePrimaryKey single integer column primary key is declared as
+//INTEGER PRIMARY KEY so that it become alias of SQLite rowid
+func (dialect *SQLiteDialect) InlinePrimaryKey(tableDef *TableDefinition) bool {
+	if len(tableDef.PrimaryKey) != 1 {
+		return false
+	}
+
+	for _, col := range tableDef.Columns {
+		if strings.Compare(col.Name, tableDef.PrimaryKey[0]) == 0 {
+			return col.DataType == INTEGER
+		}
+	}
+
+	return false
+}
+
+//UniqueKey generate named UNIQUE constraint clause
+func (dialect *SQLiteDialect) UniqueKey(tableName string, columnNames []string) string {
+	return fmt.Sprintf("CONSTRAINT %s UNIQUE (%s)",
+		dialect.QuoteIdentifier(uniqueKeyName(tableName, columnNames)),
+		quoteIdentifiers(dialect, columnNames))
+}
+
+//InlineIndex SQLite not allow index key declared inside CREATE TABLE statement
+func (dialect *SQLiteDialect) InlineIndex() bool {
+	return false
+}
+
+//IndexKey generate CREATE INDEX statement
+func (dialect *SQLiteDialect) IndexKey(tableName string, columnNames []string) string {
+	return fmt.Sprintf("CREATE INDEX %s ON %s (%s);",
+		dialect.QuoteIdentifier(indexKeyName(tableName, columnNames)),
+		dialect.QuoteIdentifier(tableName),
+		quoteIdentifiers(dialect, columnNames))
+}
+
+//Limit generate LIMIT ... OFFSET ... SQL string
+func (dialect *SQLiteDialect) Limit(limit *LimitDefinition) (string, error) {
+	return fmt.Sprintf("LIMIT %d OFFSET %d", limit.RowCount, limit.Offset), nil
+}
+
+//ValidateQuery all query definition is supported
+func (dialect *SQLiteDialect) ValidateQuery(query *SelectDefinition) error {
+	return nil
+}
+
+//Placeholder generate question mark (?) placeholder
+func (dialect *SQLiteDialect) Placeholder(index int) string {
+	return "?"
+}
+
+//UpdateJoin join other table(s) with UPDATE ... SET ... FROM (SQLite 3.33 onward) syntax
+func (dialect *SQLiteDialect) UpdateJoin() UpdateJoinStyle {
+	return UpdateJoinFrom
+}
+
+//DeleteJoin SQLite DELETE statement not support JOIN
+func (dialect *SQLiteDialect) DeleteJoin() DeleteJoinStyle {
+	return DeleteJoinNone
+}
+
+//DeleteLimit DELETE ... LIMIT n is not supported
+func (dialect *SQLiteDialect) DeleteLimit() bool {
+	return false
+}
+
+//Upsert insert or update row with INSERT ... ON CONFLICT syntax (SQLite 3.24+)
+func (dialect *SQLiteDialect) Upsert() UpsertStyle {
+	return UpsertOnConflict
+}
+
+//AlterTable generate SQLite ALTER TABLE, CREATE INDEX, or DROP INDEX statement;
+//SQLite only support add / drop column and index, other operations require table rebuild
+func (dialect *SQLiteDialect) AlterTable(tableName string, operation *AlterTableOperation) (string, error) {
+	prefix := "ALTER TABLE " + dialect.QuoteIdentifier(tableName) + " "
+
+	switch operation.Type {
+	case AddColumn:
+		colSQL, err := columnSQL(dialect, operation.Column)
+		if err != nil {
+			return "", err
+		}
+		return prefix + "ADD COLUMN " + colSQL + ";", nil
+	case DropColumn:
+		return prefix + "DROP COLUMN " + dialect.QuoteIdentifier(operation.Column.Name) + ";", nil
+	case AddIndexKey:
+		return dialect.IndexKey(tableName, operation.ColumnNames), nil
+	case DropIndexKey:
+		return "DROP INDEX " + dialect.QuoteIdentifier(indexKeyName(tableName, operation.ColumnNames)) + ";", nil
+	default:
+		return "", fmt.Errorf("SQLite not support ALTER TABLE %s; table (%s) must be rebuilt",
+			operation.Type, tableName)
+	}
+}
+
+//TransactionalDDL SQLite DDL statement can be rolled back
+func (dialect *SQLiteDialect) TransactionalDDL() bool {
+	return true
+}
+
+//TableExistsQuery count data table from sqlite_master
+func (dialect *SQLiteDialect) TableExistsQuery() string {
+	return "SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = ?"
+}
@@ -0,0 +1,62 @@
+package rdbmstool
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSQLiteDialect_TableSQL(t *testing.T) {
+	builder := NewTableBuilder().
+		Dialect(NewSQLiteDialect()).
+		TableName("member").
+		AddColumnInt("id", 10, false).
+		AddColumnVarchar("name", 100, false).
+		AddColumnDecimal("credit", 10, 2, true).
+		AddColumnBoolean("is_vip", false).
+		AddColumnDate("join_on", false).
+		AddPrimaryKey("id").
+		AddUniqueKey("name").
+		AddIndexKey("join_on")
+
+	expectedSQL := "CREATE TABLE \"member\"(\n" +
+		"\"id\" INTEGER NOT NULL PRIMARY KEY,\n" +
+		"\"name\" TEXT NOT NULL,\n" +
+		"\"credit\" NUMERIC NULL,\n" +
+		"\"is_vip\" INTEGER NOT NULL,\n" +
+		"\"join_on\" TEXT NOT NULL,\n" +
+		"CONSTRAINT \"member_name_key\" UNIQUE (\"name\")\n" +
+		");\n" +
+		"CREATE INDEX \"member_join_on_idx\" ON \"member\" (\"join_on\");"
+
+	sql, err := builder.SQL()
+	if err != nil {
+		t.Error(err)
+	}
+
+	if strings.Compare(expectedSQL, sql) != 0 {
+		t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expectedSQL, sql)
+	}
+
+	//composite primary key still use PRIMARY KEY clause
+	sql, err = NewTableBuilder().
+		Dialect(NewSQLiteDialect()).
+		TableName("member_role").
+		AddColumnInt("member_id", 10, false).
+		AddColumnInt("role_id", 10, false).
+		AddPrimaryKey("member_id").
+		AddPrimaryKey("role_id").
+		SQL()
+	if err != nil {
+		t.Error(err)
+	}
+
+	expectedSQL = "CREATE TABLE \"member_role\"(\n" +
+		"\"member_id\" INTEGER NOT NULL,\n" +
+		"\"role_id\" INTEGER NOT NULL,\n" +
+		"PRIMARY KEY(\"member_id\",\"role_id\")\n" +
+		");"
+
+	if strings.Compare(expectedSQL, sql) != 0 {
+		t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expectedSQL, sql)
+	}
+}
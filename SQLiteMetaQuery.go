@@ -0,0 +1,402 @@
+package rdbmstool
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//SQLiteMetaQuery SQLite implementation of MetaQuery; backed by sqlite_master and PRAGMA statements
+//NOTE: database name parameter refer to schema name (main, temp, or attached database name);
+//empty string means main
+type SQLiteMetaQuery struct {
+	dialect *SQLiteDialect
+}
+
+//NewSQLiteMetaQuery create SQLite meta query
+func NewSQLiteMetaQuery() *SQLiteMetaQuery {
+	return &SQLiteMetaQuery{dialect: NewSQLiteDialect()}
+}
+
+func (meta *SQLiteMetaQuery) schema(databaseName string) string {
+	if strings.Compare(databaseName, "") == 0 {
+		databaseName = "main"
+	}
+
+	return meta.dialect.QuoteIdentifier(databaseName)
+}
+
+//GetTableNames get data table names of database which match name pattern
+func (meta *SQLiteMetaQuery) GetTableNames(db DbHandlerProxy, databaseName string, tableNamePattern string) ([]string, error) {
+	return queryStrings(db,
+		"SELECT name FROM "+meta.schema(databaseName)+".sqlite_master "+
+			"WHERE type = 'table' AND name NOT LIKE 'sqlite_%' AND name LIKE ? "+
+			"ORDER BY name",
+		tableNamePattern)
+}
+
+//GetTableNamesByPattern get data table names of databases (main and attached) which name
+//match regular expression
+func (meta *SQLiteMetaQuery) GetTableNamesByPattern(db DbHandlerProxy, databaseNamePattern string, tableNamePattern string) ([]string, error) {
+	pattern, err := regexp.Compile(databaseNamePattern)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.Query("PRAGMA database_list")
+	if err != nil {
+		return nil, err
+	}
+
+	databaseNames := []string{}
+	for rows.Next() {
+		var seq int
+		var name string
+		var file sql.NullString
+
+		if err := rows.Scan(&seq, &name, &file); err != nil {
+			rows.Close()
+			return nil, err
+		}
+
+		if pattern.MatchString(name) {
+			databaseNames = append(databaseNames, name)
+		}
+	}
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	found := map[string]bool{}
+	result := []string{}
+	for _, databaseName := range databaseNames {
+		names, err := meta.GetTableNames(db, databaseName, tableNamePattern)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, name := range names {
+			if !found[name] {
+				found[name] = true
+				result = append(result, name)
+			}
+		}
+	}
+
+	sort.Strings(result)
+
+	return result, nil
+}
+
+//GetTableDefinition read data table definition; include columns, primary key,
+//unique keys, indices, and foreign keys
+//NOTE: SQLite not expose foreign key name, hence foreign key name is left empty
+func (meta *SQLiteMetaQuery) GetTableDefinition(db DbHandlerProxy, databaseName string, tableName string) (*TableDefinition, error) {
+	tableDef := &TableDefinition{
+		Name:        tableName,
+		Columns:     []ColumnDefinition{},
+		PrimaryKey:  []string{},
+		ForiegnKeys: []ForeignKeyDefinition{},
+		UniqueKeys:  []UniqueKeyDefinition{},
+		Indices:     []IndexKeyDefinition{}}
+
+	if err := meta.readColumns(db, databaseName, tableDef); err != nil {
+		return nil, err
+	}
+
+	if len(tableDef.Columns) == 0 {
+		return nil, fmt.Errorf("table (%s) not found in database (%s)", tableName, databaseName)
+	}
+
+	if err := meta.readKeys(db, databaseName, tableDef); err != nil {
+		return nil, err
+	}
+
+	if err := meta.readForeignKeys(db, databaseName, tableDef); err != nil {
+		return nil, err
+	}
+
+	return tableDef, nil
+}
+
+func (meta *SQLiteMetaQuery) readColumns(db DbHandlerProxy, databaseName string, tableDef *TableDefinition) error {
+	rows, err := db.Query(
+		"PRAGMA " + meta.schema(databaseName) + ".table_info(" + meta.dialect.QuoteIdentifier(tableDef.Name) + ")")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	pkColumns := map[int]string{}
+	for rows.Next() {
+		var cid, notNull, pk int
+		var name, declaredType string
+		var defaultValue sql.NullString
+
+		if err := rows.Scan(&cid, &name, &declaredType, &notNull, &defaultValue, &pk); err != nil {
+			return err
+		}
+
+		colDef := ColumnDefinition{Name: name, IsNullable: notNull == 0}
+		if err := meta.mapColumnType(&colDef, declaredType); err != nil {
+			return fmt.Errorf("table (%s) column (%s): %s", tableDef.Name, name, err.Error())
+		}
+
+		if pk > 0 {
+			pkColumns[pk] = name
+		}
+
+		tableDef.Columns = append(tableDef.Columns, colDef)
+	}
+
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for index := 1; index <= len(pkColumns); index++ {
+		tableDef.PrimaryKey = append(tableDef.PrimaryKey, pkColumns[index])
+	}
+
+	//single INTEGER primary key is alias of rowid
+	if len(tableDef.PrimaryKey) == 1 {
+		colDef := tableDef.findColumn(tableDef.PrimaryKey[0])
+		if colDef.DataType == INTEGER {
+			colDef.IsAutoIncrement = true
+		}
+	}
+
+	return nil
+}
+
+var sqliteDeclaredType = regexp.MustCompile(`^\s*([A-Za-z ]+?)\s*(?:\(\s*(\d+)\s*(?:,\s*(\d+)\s*)?\))?\s*$`)
+
+//mapColumnType map SQLite declared type onto ColumnDataType
+func (meta *SQLiteMetaQuery) mapColumnType(colDef *ColumnDefinition, declaredType string) error {
+	match := sqliteDeclaredType.FindStringSubmatch(declaredType)
+	if match == nil {
+		return fmt.Errorf("unsupported data type: %s", declaredType)
+	}
+
+	typeName := strings.ToUpper(match[1])
+	length, _ := strconv.Atoi(match[2])
+	precision, _ := strconv.Atoi(match[3])
+
+	switch {
+	case strings.Contains(typeName, "BOOL"):
+		colDef.DataType = BOOLEAN
+	case strings.Contains(typeName, "INT"):
+		colDef.DataType, colDef.Length = INTEGER, 11
+		if length > 0 {
+			colDef.Length = length
+		}
+	case strings.Contains(typeName, "CHAR") && length > 0:
+		colDef.DataType, colDef.Length = VARCHAR, length
+		if typeName == "CHAR" || typeName == "NCHAR" || typeName == "CHARACTER" {
+			colDef.DataType = CHAR
+		}
+	case strings.Contains(typeName, "CHAR"), strings.Contains(typeName, "TEXT"),
+		strings.Contains(typeName, "CLOB"):
+		colDef.DataType = TEXT
+	case typeName == "DATE":
+		colDef.DataType = DATE
+	case strings.Contains(typeName, "DATETIME"), strings.Contains(typeName, "TIMESTAMP"):
+		colDef.DataType = DATETIME
+	case strings.Contains(typeName, "DEC"), strings.Contains(typeName, "NUMERIC"):
+		colDef.DataType, colDef.Length, colDef.DecimalPrecision = DECIMAL, 10, 0
+		if length > 0 {
+			colDef.Length, colDef.DecimalPrecision = length, precision
+		}
+	case strings.Contains(typeName, "DOUB"), strings.Contains(typeName, "REAL"):
+		colDef.DataType = DOUBLE
+	case strings.Contains(typeName, "FLOA"):
+		colDef.DataType = FLOAT
+	default:
+		return fmt.Errorf("unsupported data type: %s", declaredType)
+	}
+
+	return nil
+}
+
+func (meta *SQLiteMetaQuery) readKeys(db DbHandlerProxy, databaseName string, tableDef *TableDefinition) error {
+	type sqliteIndex struct {
+		seq      int
+		name     string
+		isUnique bool
+	}
+
+	rows, err := db.Query(
+		"PRAGMA " + meta.schema(databaseName) + ".index_list(" + meta.dialect.QuoteIdentifier(tableDef.Name) + ")")
+	if err != nil {
+		return err
+	}
+
+	indices := []sqliteIndex{}
+	for rows.Next() {
+		var seq, unique, partial int
+		var name, origin string
+
+		if err := rows.Scan(&seq, &name, &unique, &origin, &partial); err != nil {
+			rows.Close()
+			return err
+		}
+
+		//primary key already read from table_info
+		if strings.Compare(origin, "pk") != 0 {
+			indices = append(indices, sqliteIndex{seq: seq, name: name, isUnique: unique == 1})
+		}
+	}
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	//index_list return latest created index first
+	sort.Slice(indices, func(i, j int) bool { return indices[i].seq > indices[j].seq })
+
+	keys := &metaKeyCollector{}
+	for _, index := range indices {
+		columnNames, err := queryIndexColumns(db,
+			"PRAGMA "+meta.schema(databaseName)+".index_info("+meta.dialect.QuoteIdentifier(index.name)+")")
+		if err != nil {
+			return err
+		}
+
+		for _, columnName := range columnNames {
+			keys.add(index.name, false, index.isUnique, columnName)
+		}
+	}
+
+	keys.apply(tableDef, nil)
+
+	return nil
+}
+
+//queryIndexColumns read column names from PRAGMA index_info (seqno, cid, name)
+func queryIndexColumns(db DbHandlerProxy, query string) ([]string, error) {
+	rows, err := db.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []string{}
+	for rows.Next() {
+		var seqNo, cid int
+		var name string
+
+		if err := rows.Scan(&seqNo, &cid, &name); err != nil {
+			return nil, err
+		}
+
+		result = append(result, name)
+	}
+
+	return result, rows.Err()
+}
+
+func (meta *SQLiteMetaQuery) readForeignKeys(db DbHandlerProxy, databaseName string, tableDef *TableDefinition) error {
+	rows, err := db.Query(
+		"PRAGMA " + meta.schema(databaseName) + ".foreign_key_list(" + meta.dialect.QuoteIdentifier(tableDef.Name) + ")")
+	if err != nil {
+		return err
+	}
+
+	type sqliteFKColumn struct {
+		id            int
+		refTableName  string
+		columnName    string
+		refColumnName sql.NullString
+	}
+
+	columns := []sqliteFKColumn{}
+	for rows.Next() {
+		var id, seq int
+		var refTableName, columnName, onUpdate, onDelete, match string
+		var refColumnName sql.NullString
+
+		if err := rows.Scan(&id, &seq, &refTableName, &columnName, &refColumnName,
+			&onUpdate, &onDelete, &match); err != nil {
+			rows.Close()
+			return err
+		}
+
+		columns = append(columns, sqliteFKColumn{id, refTableName, columnName, refColumnName})
+	}
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	//foreign_key_list return latest declared foreign key first
+	sort.SliceStable(columns, func(i, j int) bool { return columns[i].id > columns[j].id })
+
+	for _, column := range columns {
+		refColumnName := column.refColumnName.String
+
+		//reference column omitted; refer to primary key of reference table
+		if !column.refColumnName.Valid || strings.Compare(refColumnName, "") == 0 {
+			refDef := &TableDefinition{Name: column.refTableName}
+			if err := meta.readColumns(db, databaseName, refDef); err != nil {
+				return err
+			}
+
+			fkIndex := 0
+			for _, fk := range tableDef.ForiegnKeys {
+				if strings.Compare(fk.Name, strconv.Itoa(column.id)) == 0 {
+					fkIndex = len(fk.Columns)
+				}
+			}
+
+			if fkIndex < len(refDef.PrimaryKey) {
+				refColumnName = refDef.PrimaryKey[fkIndex]
+			}
+		}
+
+		//temporary name to group multi-column foreign key
+		appendForeignKeyColumn(tableDef, strconv.Itoa(column.id), column.refTableName,
+			column.columnName, refColumnName)
+	}
+
+	for index := range tableDef.ForiegnKeys {
+		tableDef.ForiegnKeys[index].Name = ""
+	}
+
+	return nil
+}
+
+//GetViewNames get view names of database which match name pattern
+func (meta *SQLiteMetaQuery) GetViewNames(db DbHandlerProxy, databaseName string, viewNamePattern string) ([]string, error) {
+	return queryStrings(db,
+		"SELECT name FROM "+meta.schema(databaseName)+".sqlite_master "+
+			"WHERE type = 'view' AND name LIKE ? "+
+			"ORDER BY name",
+		viewNamePattern)
+}
+
+//GetViewDefinition read view definition; view query is parsed into query builder
+func (meta *SQLiteMetaQuery) GetViewDefinition(db DbHandlerProxy, databaseName string, viewName string) (*ViewDefinition, error) {
+	var createSQL string
+	err := db.QueryRow(
+		"SELECT sql FROM "+meta.schema(databaseName)+".sqlite_master WHERE type = 'view' AND name = ?",
+		viewName).Scan(&createSQL)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("view (%s) not found in database (%s)", viewName, databaseName)
+	} else if err != nil {
+		return nil, err
+	}
+
+	viewDef, err := ParseViewDefinitionDialect(createSQL, NewSQLiteDialect())
+	if err != nil {
+		return nil, fmt.Errorf("view (%s) definition cannot be parsed: %s", viewName, err.Error())
+	}
+	viewDef.Name = viewName
+
+	return viewDef, nil
+}
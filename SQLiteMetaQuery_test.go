@@ -0,0 +1,161 @@
+//go:build cgo
+// +build cgo
+
+package rdbmstool
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func openSQLiteTestDB(t *testing.T) (*sql.DB, func()) {
+	dir, err := ioutil.TempDir("", "rdbmstool")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := sql.Open("sqlite3", filepath.Join(dir, "test.db"))
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+
+	return db, func() {
+		db.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestSQLiteMetaQuery_GetTableDefinition(t *testing.T) {
+	db, cleanup := openSQLiteTestDB(t)
+	defer cleanup()
+
+	customer := NewTableBuilder().
+		Dialect(NewSQLiteDialect()).
+		TableName("customer").
+		AddColumnInt("id", 11, false).
+		AddColumnInt("branch_id", 11, false).
+		AddColumnVarchar("name", 100, false).
+		AddPrimaryKey("id").
+		AddPrimaryKey("branch_id")
+
+	invoice := NewTableBuilder().
+		Dialect(NewSQLiteDialect()).
+		TableName("invoice").
+		AddColumnInt("id", 11, false).
+		AddColumnText("code", false).
+		AddColumnDecimal("amount", 10, 0, true).
+		AddColumnFloat("rate", false).
+		AddColumnText("issue_on", false).
+		AddColumnInt("customer_id", 11, false).
+		AddColumnInt("branch_id", 11, false).
+		AddPrimaryKey("id").
+		AddUniqueKey("code").
+		AddIndexKey("issue_on").
+		AddIndexKeyMultiColumn([]string{"customer_id", "branch_id"}).
+		AddForeignKeyMultiColumn("customer", []FKColumnDefinition{
+			FKColumnDefinition{ColumnName: "customer_id", RefColumnName: "id"},
+			FKColumnDefinition{ColumnName: "branch_id", RefColumnName: "branch_id"}})
+
+	for _, builder := range []*TableBuilder{customer, invoice} {
+		sql, err := builder.SQL()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := db.Exec(sql); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var meta MetaQuery = NewSQLiteMetaQuery()
+
+	names, err := meta.GetTableNames(db, "", "%")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Compare("customer,invoice", strings.Join(names, ",")) != 0 {
+		t.Errorf("Expect customer,invoice but get %s", strings.Join(names, ","))
+	}
+
+	for _, builder := range []*TableBuilder{customer, invoice} {
+		tableDef, err := meta.GetTableDefinition(db, "main", builder.GetTableName())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expectedSQL, _ := builder.SQL()
+		sql, err := tableDef.SQLDialect(NewSQLiteDialect())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if strings.Compare(expectedSQL, sql) != 0 {
+			t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expectedSQL, sql)
+		}
+	}
+
+	if _, err := meta.GetTableDefinition(db, "main", "payment"); err == nil {
+		t.Error("Expect error for table not exists")
+	}
+}
+
+func TestSQLiteMetaQuery_GetViewDefinition(t *testing.T) {
+	db, cleanup := openSQLiteTestDB(t)
+	defer cleanup()
+
+	viewDef := NewViewDefinition("customer_total")
+	viewDef.Query.
+		Select("c.name", "").
+		Select("SUM(i.amount)", "total").
+		From("customer", "c").
+		JoinAdd("invoice", "i", LeftJoin, "i.customer_id = c.id").
+		GroupBy("c.name", true)
+
+	expectedSQL, err := viewDef.SQLDialect(NewSQLiteDialect())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	statements := []string{
+		"CREATE TABLE customer(id INTEGER PRIMARY KEY, name TEXT)",
+		"CREATE TABLE invoice(id INTEGER PRIMARY KEY, customer_id INTEGER, amount NUMERIC)",
+		expectedSQL}
+	for _, statement := range statements {
+		if _, err := db.Exec(statement); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var meta MetaQuery = NewSQLiteMetaQuery()
+
+	names, err := meta.GetViewNames(db, "", "%")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Compare("customer_total", strings.Join(names, ",")) != 0 {
+		t.Errorf("Expect customer_total but get %s", strings.Join(names, ","))
+	}
+
+	result, err := meta.GetViewDefinition(db, "", "customer_total")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sql, err := result.SQLDialect(NewSQLiteDialect())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Compare(expectedSQL, sql) != 0 {
+		t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expectedSQL, sql)
+	}
+}
@@ -0,0 +1,383 @@
+package rdbmstool
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+//SchemaDefinition database schema definition; hold many tables and views
+type SchemaDefinition struct {
+	Tables []*TableDefinition
+	Views  []*ViewDefinition
+}
+
+//schemaTable table to create with foreign keys which deferred to trailing ALTER TABLE statement
+type schemaTable struct {
+	table    *TableDefinition
+	deferred []AlterTableOperation
+}
+
+//NewSchemaDefinition create new empty schema definition
+func NewSchemaDefinition() *SchemaDefinition {
+	return &SchemaDefinition{
+		Tables: []*TableDefinition{},
+		Views:  []*ViewDefinition{}}
+}
+
+//AddTable append table definition
+func (schema *SchemaDefinition) AddTable(tableDef *TableDefinition) *SchemaDefinition {
+	schema.Tables = append(schema.Tables, tableDef)
+	return schema
+}
+
+//AddView append view definition
+func (schema *SchemaDefinition) AddView(viewDef *ViewDefinition) *SchemaDefinition {
+	schema.Views = append(schema.Views, viewDef)
+	return schema
+}
+
+//SQL generate full CREATE DDL script with default dialect
+func (schema *SchemaDefinition) SQL() (string, error) {
+	return schema.SQLDialect(DefaultDialect())
+}
+
+//SQLDialect generate full CREATE DDL script with specified dialect
+func (schema *SchemaDefinition) SQLDialect(dialect Dialect) (string, error) {
+	statements, err := schema.CreateStatements(dialect)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Join(statements, "\n\n"), nil
+}
+
+//DropSQL generate full DROP DDL script with specified dialect
+func (schema *SchemaDefinition) DropSQL(dialect Dialect) (string, error) {
+	statements, err := schema.DropStatements(dialect)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Join(statements, "\n"), nil
+}
+
+//CreateStatements generate CREATE TABLE and CREATE VIEW statements; tables are ordered
+//by foreign key dependencies and views are ordered by their source tables and views.
+//Foreign key cycle is resolved by trailing ALTER TABLE ... ADD statement
+func (schema *SchemaDefinition) CreateStatements(dialect Dialect) ([]string, error) {
+	dialect = resolveDialect(dialect)
+
+	tables, views, err := schema.sort()
+	if err != nil {
+		return nil, err
+	}
+
+	result := []string{}
+	for _, item := range tables {
+		statements, sqlErr := item.table.Statements(dialect)
+		if sqlErr != nil {
+			return nil, fmt.Errorf("Failed to generate table (%s) SQL string: %s", item.table.Name, sqlErr.Error())
+		}
+		result = append(result, statements...)
+	}
+
+	for _, item := range tables {
+		for index := range item.deferred {
+			sql, sqlErr := dialect.AlterTable(item.table.Name, &item.deferred[index])
+			if sqlErr != nil {
+				return nil, fmt.Errorf("Failed to generate deferred foreign key (%s) SQL string: %s",
+					item.deferred[index].ForeignKeyName, sqlErr.Error())
+			}
+			result = append(result, sql)
+		}
+	}
+
+	for _, view := range views {
+		sql, sqlErr := view.SQLDialect(dialect)
+		if sqlErr != nil {
+			return nil, fmt.Errorf("Failed to generate view (%s) SQL string: %s", view.Name, sqlErr.Error())
+		}
+		result = append(result, sql+";")
+	}
+
+	return result, nil
+}
+
+//DropStatements generate DROP VIEW and DROP TABLE statements in reverse creation order;
+//deferred foreign keys are dropped first
+func (schema *SchemaDefinition) DropStatements(dialect Dialect) ([]string, error) {
+	dialect = resolveDialect(dialect)
+
+	tables, views, err := schema.sort()
+	if err != nil {
+		return nil, err
+	}
+
+	result := []string{}
+	for index := len(views) - 1; index >= 0; index-- {
+		result = append(result, "DROP VIEW "+dialect.QuoteIdentifier(views[index].Name)+";")
+	}
+
+	for _, item := range tables {
+		for index := range item.deferred {
+			dropFK := item.deferred[index]
+			dropFK.Type = DropForeignKey
+
+			sql, sqlErr := dialect.AlterTable(item.table.Name, &dropFK)
+			if sqlErr != nil {
+				return nil, fmt.Errorf("Failed to generate drop foreign key (%s) SQL string: %s",
+					dropFK.ForeignKeyName, sqlErr.Error())
+			}
+			result = append(result, sql)
+		}
+	}
+
+	for index := len(tables) - 1; index >= 0; index-- {
+		result = append(result, "DROP TABLE "+dialect.QuoteIdentifier(tables[index].table.Name)+";")
+	}
+
+	return result, nil
+}
+
+//Validate check every table and view definition integrity and duplicate names
+func (schema *SchemaDefinition) Validate() error {
+	v := &validator{}
+	names := map[string]bool{}
+
+	for index, tableDef := range schema.Tables {
+		field := fmt.Sprintf("table[%d](%s)", index, tableDef.Name)
+		if names[tableDef.Name] {
+			v.add(field, "duplicate table or view name")
+		}
+		names[tableDef.Name] = true
+
+		v.merge(field, tableDef.Validate())
+	}
+
+	for index, viewDef := range schema.Views {
+		field := fmt.Sprintf("view[%d](%s)", index, viewDef.Name)
+		if names[viewDef.Name] {
+			v.add(field, "duplicate table or view name")
+		}
+		names[viewDef.Name] = true
+
+		v.merge(field, viewDef.Validate())
+	}
+
+	if _, _, err := schema.sort(); err != nil {
+		v.add("views", "%s", err.Error())
+	}
+
+	return v.result()
+}
+
+//sort order tables and views by dependencies; definition order is kept when possible
+func (schema *SchemaDefinition) sort() ([]schemaTable, []*ViewDefinition, error) {
+	tableNames := map[string]bool{}
+	for _, tableDef := range schema.Tables {
+		if tableDef == nil {
+			return nil, nil, errors.New("schema table definition cannot be null")
+		}
+		tableNames[tableDef.Name] = true
+	}
+
+	//tables
+	created := map[string]bool{}
+	remaining := append([]*TableDefinition{}, schema.Tables...)
+	tables := []schemaTable{}
+
+	for len(remaining) > 0 {
+		picked := -1
+		for index, tableDef := range remaining {
+			if len(pendingForeignKeys(tableDef, tableNames, created)) == 0 {
+				picked = index
+				break
+			}
+		}
+
+		if picked >= 0 {
+			tables = append(tables, schemaTable{table: remaining[picked]})
+		} else {
+			//foreign key cycle; defer pending foreign keys of first table found in cycle
+			picked = findCycleTable(remaining, tableNames, created)
+			tables = append(tables, deferForeignKeys(remaining[picked],
+				pendingForeignKeys(remaining[picked], tableNames, created)))
+		}
+
+		created[remaining[picked].Name] = true
+		remaining = append(remaining[:picked], remaining[picked+1:]...)
+	}
+
+	//views
+	remainingViews := append([]*ViewDefinition{}, schema.Views...)
+	views := []*ViewDefinition{}
+
+	viewNames := map[string]bool{}
+	for _, viewDef := range schema.Views {
+		if viewDef == nil {
+			return nil, nil, errors.New("schema view definition cannot be null")
+		}
+		viewNames[viewDef.Name] = true
+	}
+
+	for len(remainingViews) > 0 {
+		picked := -1
+		for index, viewDef := range remainingViews {
+			ready := true
+			for _, source := range viewSources(viewDef) {
+				if viewNames[source] && !created[source] && strings.Compare(source, viewDef.Name) != 0 {
+					ready = false
+					break
+				}
+			}
+
+			if ready {
+				picked = index
+				break
+			}
+		}
+
+		if picked < 0 {
+			names := []string{}
+			for _, viewDef := range remainingViews {
+				names = append(names, viewDef.Name)
+			}
+			return nil, nil, fmt.Errorf("circular view dependency found: %s", strings.Join(names, ", "))
+		}
+
+		views = append(views, remainingViews[picked])
+		created[remainingViews[picked].Name] = true
+		remainingViews = append(remainingViews[:picked], remainingViews[picked+1:]...)
+	}
+
+	return tables, views, nil
+}
+
+//pendingForeignKeys get index of foreign keys which reference table in schema that is not created yet;
+//self-reference foreign key is not pending
+func pendingForeignKeys(tableDef *TableDefinition, tableNames map[string]bool, created map[string]bool) []int {
+	result := []int{}
+	for index, fk := range tableDef.ForiegnKeys {
+		if tableNames[fk.ReferenceTableName] && !created[fk.ReferenceTableName] &&
+			strings.Compare(fk.ReferenceTableName, tableDef.Name) != 0 {
+			result = append(result, index)
+		}
+	}
+
+	return result
+}
+
+//findCycleTable get index of first remaining table which is part of foreign key cycle
+func findCycleTable(remaining []*TableDefinition, tableNames map[string]bool, created map[string]bool) int {
+	byName := map[string]*TableDefinition{}
+	for _, tableDef := range remaining {
+		byName[tableDef.Name] = tableDef
+	}
+
+	for index, tableDef := range remaining {
+		visited := map[string]bool{}
+		stack := []string{tableDef.Name}
+
+		for len(stack) > 0 {
+			current := byName[stack[len(stack)-1]]
+			stack = stack[:len(stack)-1]
+
+			for _, fkIndex := range pendingForeignKeys(current, tableNames, created) {
+				refName := current.ForiegnKeys[fkIndex].ReferenceTableName
+				if strings.Compare(refName, tableDef.Name) == 0 {
+					return index
+				}
+
+				if !visited[refName] && byName[refName] != nil {
+					visited[refName] = true
+					stack = append(stack, refName)
+				}
+			}
+		}
+	}
+
+	//unreachable: every remaining table has pending dependency, hence cycle must exist
+	return 0
+}
+
+//deferForeignKeys copy table definition without specified foreign keys; removed foreign keys
+//are returned as ADD FOREIGN KEY operations. Foreign key name is resolved before removal
+//so that generated name is kept identical
+func deferForeignKeys(tableDef *TableDefinition, fkIndices []int) schemaTable {
+	tableCopy := *tableDef
+	tableCopy.ForiegnKeys = []ForeignKeyDefinition{}
+
+	result := schemaTable{table: &tableCopy, deferred: []AlterTableOperation{}}
+
+	for index := range tableDef.ForiegnKeys {
+		fk := tableDef.ForiegnKeys[index]
+		fk.Name = foreignKeyName(tableDef.Name, index, &tableDef.ForiegnKeys[index])
+
+		isDeferred := false
+		for _, fkIndex := range fkIndices {
+			if fkIndex == index {
+				isDeferred = true
+				break
+			}
+		}
+
+		if isDeferred {
+			fkCopy := fk
+			result.deferred = append(result.deferred, AlterTableOperation{
+				Type:           AddForeignKey,
+				ForeignKeyName: fk.Name,
+				ForeignKey:     &fkCopy})
+		} else {
+			tableCopy.ForiegnKeys = append(tableCopy.ForiegnKeys, fk)
+		}
+	}
+
+	return result
+}
+
+//viewSources get source table and view names referred by view query (FROM, JOIN, sub-query, UNION)
+func viewSources(viewDef *ViewDefinition) []string {
+	if viewDef.Query == nil || viewDef.Query.selectDefinition == nil {
+		return nil
+	}
+
+	return querySources(viewDef.Query.selectDefinition)
+}
+
+func querySources(query *SelectDefinition) []string {
+	result := []string{}
+
+	if query.From != nil {
+		if query.From.queryBuilder != nil {
+			result = append(result, querySources(query.From.queryBuilder)...)
+		} else {
+			result = append(result, sourceName(query.From.expression))
+		}
+	}
+
+	for _, join := range query.Join {
+		if join.subQuery != nil {
+			result = append(result, querySources(join.subQuery)...)
+		} else {
+			result = append(result, sourceName(join.source))
+		}
+	}
+
+	for index := range query.Union {
+		result = append(result, querySources(&query.Union[index])...)
+	}
+
+	return result
+}
+
+//sourceName strip identifier quote and schema prefix from source expression
+//example: `db`.`student` => student
+func sourceName(expression string) string {
+	name := strings.TrimSpace(expression)
+	if index := strings.LastIndex(name, "."); index >= 0 {
+		name = name[index+1:]
+	}
+
+	return strings.Trim(name, "`\"[]")
+}
@@ -0,0 +1,97 @@
+package rdbmstool
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSchemaDefinition_SQL(t *testing.T) {
+	invoice := NewTableBuilder().
+		TableName("invoice").
+		AddColumnInt("id", 11, false).
+		AddColumnInt("customer_id", 11, false).
+		AddPrimaryKey("id").
+		AddForeignKey("customer_id", "customer", "id").
+		GetTableDefinition()
+
+	//customer and agent reference each other
+	customer := NewTableBuilder().
+		TableName("customer").
+		AddColumnInt("id", 11, false).
+		AddColumnInt("agent_id", 11, true).
+		AddPrimaryKey("id").
+		AddForeignKey("agent_id", "agent", "id").
+		GetTableDefinition()
+
+	agent := NewTableBuilder().
+		TableName("agent").
+		AddColumnInt("id", 11, false).
+		AddColumnInt("top_customer_id", 11, true).
+		AddPrimaryKey("id").
+		AddForeignKey("top_customer_id", "customer", "id").
+		GetTableDefinition()
+
+	vipInvoice := NewViewDefinition("vip_invoice")
+	vipInvoice.Query.Select("a.id", "").From("customer_invoice", "a")
+
+	customerInvoice := NewViewDefinition("customer_invoice")
+	customerInvoice.Query.Select("a.id", "").
+		From("invoice", "a").
+		Join("customer", "b", InnerJoin, "a.customer_id = b.id")
+
+	schema := NewSchemaDefinition().
+		AddTable(invoice).
+		AddTable(customer).
+		AddTable(agent).
+		AddView(vipInvoice).
+		AddView(customerInvoice)
+
+	if err := schema.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	statements, err := schema.CreateStatements(NewPostgreSQLDialect())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedPrefixes := []string{
+		"CREATE TABLE \"customer\"(",
+		"CREATE TABLE \"invoice\"(",
+		"CREATE TABLE \"agent\"(",
+		"ALTER TABLE \"customer\" ADD CONSTRAINT \"customer_ibfk_1\" FOREIGN KEY (\"agent_id\") REFERENCES \"agent\" (\"id\");",
+		"CREATE VIEW \"customer_invoice\" AS",
+		"CREATE VIEW \"vip_invoice\" AS",
+	}
+
+	if len(statements) != len(expectedPrefixes) {
+		t.Fatalf("expect %d statements but get %d:\n%s",
+			len(expectedPrefixes), len(statements), strings.Join(statements, "\n"))
+	}
+
+	for index, prefix := range expectedPrefixes {
+		if !strings.HasPrefix(statements[index], prefix) {
+			t.Errorf("statement %d expect start with:\n%s\n\nbut get:\n\n%s", index, prefix, statements[index])
+		}
+	}
+
+	if strings.Contains(statements[0], "FOREIGN KEY") {
+		t.Errorf("expect customer foreign key deferred but get:\n%s", statements[0])
+	}
+
+	sql, err := schema.DropSQL(NewPostgreSQLDialect())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedSQL := "DROP VIEW \"vip_invoice\";\n" +
+		"DROP VIEW \"customer_invoice\";\n" +
+		"ALTER TABLE \"customer\" DROP CONSTRAINT \"customer_ibfk_1\";\n" +
+		"DROP TABLE \"agent\";\n" +
+		"DROP TABLE \"invoice\";\n" +
+		"DROP TABLE \"customer\";"
+
+	if strings.Compare(expectedSQL, sql) != 0 {
+		t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expectedSQL, sql)
+	}
+}
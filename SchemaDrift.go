@@ -0,0 +1,270 @@
+package rdbmstool
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+//DriftType kind of difference between table definition in code and live database
+type DriftType int
+
+const (
+	//DriftMissingTable table defined in code not found in database
+	DriftMissingTable DriftType = iota
+	//DriftMissingColumn column defined in code not found in database
+	DriftMissingColumn
+	//DriftExtraColumn column found in database but not defined in code
+	DriftExtraColumn
+	//DriftColumnType column data type different from code
+	DriftColumnType
+	//DriftColumnNullable column nullability different from code
+	DriftColumnNullable
+	//DriftPrimaryKey primary key columns different from code
+	DriftPrimaryKey
+	//DriftMissingUniqueKey unique key defined in code not found in database
+	DriftMissingUniqueKey
+	//DriftMissingIndexKey index key defined in code not found in database
+	DriftMissingIndexKey
+	//DriftMissingForeignKey foreign key defined in code not found in database
+	DriftMissingForeignKey
+)
+
+func (driftType DriftType) String() string {
+	switch driftType {
+	case DriftMissingTable:
+		return "missing table"
+	case DriftMissingColumn:
+		return "missing column"
+	case DriftExtraColumn:
+		return "extra column"
+	case DriftColumnType:
+		return "column type mismatch"
+	case DriftColumnNullable:
+		return "column nullability mismatch"
+	case DriftPrimaryKey:
+		return "primary key mismatch"
+	case DriftMissingUniqueKey:
+		return "missing unique key"
+	case DriftMissingIndexKey:
+		return "missing index key"
+	case DriftMissingForeignKey:
+		return "missing foreign key"
+	default:
+		return fmt.Sprintf("unknown drift (%d)", driftType)
+	}
+}
+
+//Drift a single difference between table definition in code and live database
+type Drift struct {
+	Type     DriftType
+	Table    string
+	Column   string //column name, or comma separated key column names
+	Expected string //definition in code; empty if not applicable
+	Actual   string //definition in database; empty if not applicable
+}
+
+//String return drift in "table.column: type (expected ..., actual ...)" format
+func (drift Drift) String() string {
+	result := drift.Table
+	if strings.Compare(drift.Column, "") != 0 {
+		result = result + "." + drift.Column
+	}
+
+	result = result + ": " + drift.Type.String()
+	if strings.Compare(drift.Actual, "") != 0 {
+		result = result + fmt.Sprintf(" (expected %s, actual %s)", drift.Expected, drift.Actual)
+	} else if strings.Compare(drift.Expected, "") != 0 {
+		result = result + fmt.Sprintf(" (expected %s)", drift.Expected)
+	}
+
+	return result
+}
+
+//DriftReport all differences found while checking table definitions against live database
+type DriftReport struct {
+	Drifts []Drift
+}
+
+//HasDrift check any difference is found
+func (report *DriftReport) HasDrift() bool {
+	return len(report.Drifts) > 0
+}
+
+//Error list every drift in separate line
+func (report *DriftReport) Error() string {
+	result := fmt.Sprintf("%d schema drift(s) found", len(report.Drifts))
+	for _, drift := range report.Drifts {
+		result = result + "\n- " + drift.String()
+	}
+
+	return result
+}
+
+//add register a drift
+func (report *DriftReport) add(driftType DriftType, table string, column string, expected string, actual string) {
+	report.Drifts = append(report.Drifts, Drift{
+		Type:     driftType,
+		Table:    table,
+		Column:   column,
+		Expected: expected,
+		Actual:   actual})
+}
+
+//SchemaChecker compare table definitions in code with live database through MetaQuery
+type SchemaChecker struct {
+	db      DbHandlerProxy
+	meta    MetaQuery
+	dialect Dialect
+	tables  []*TableDefinition
+}
+
+//NewSchemaChecker create schema drift checker; column data types are compared
+//after rendered by dialect so that type mapping of database is respected
+func NewSchemaChecker(db DbHandlerProxy, meta MetaQuery, dialect Dialect) *SchemaChecker {
+	return &SchemaChecker{
+		db:      db,
+		meta:    meta,
+		dialect: resolveDialect(dialect),
+		tables:  []*TableDefinition{}}
+}
+
+//AddTable append table definitions to be checked
+func (checker *SchemaChecker) AddTable(builders ...*TableBuilder) *SchemaChecker {
+	for _, builder := range builders {
+		checker.tables = append(checker.tables, builder.GetTableDefinition())
+	}
+
+	return checker
+}
+
+//AddSchema append every table definition of schema to be checked
+func (checker *SchemaChecker) AddSchema(schema *SchemaDefinition) *SchemaChecker {
+	checker.tables = append(checker.tables, schema.Tables...)
+
+	return checker
+}
+
+//Check read every table definition from database and compare with definition in code;
+//error is returned only when database cannot be queried
+func (checker *SchemaChecker) Check(databaseName string) (*DriftReport, error) {
+	report := &DriftReport{Drifts: []Drift{}}
+
+	tableNames, err := checker.meta.GetTableNames(checker.db, databaseName, "%")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, expected := range checker.tables {
+		if !containsString(tableNames, expected.Name) {
+			report.add(DriftMissingTable, expected.Name, "", "", "")
+			continue
+		}
+
+		actual, err := checker.meta.GetTableDefinition(checker.db, databaseName, expected.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read table (%s) definition: %s", expected.Name, err.Error())
+		}
+
+		if err := checker.compareTable(report, expected, actual); err != nil {
+			return nil, err
+		}
+	}
+
+	return report, nil
+}
+
+//compareTable register every difference between expected and actual table definition
+func (checker *SchemaChecker) compareTable(report *DriftReport, expected *TableDefinition, actual *TableDefinition) error {
+	for index := range expected.Columns {
+		expectedCol := &expected.Columns[index]
+		actualCol := actual.findColumn(expectedCol.Name)
+
+		if actualCol == nil {
+			report.add(DriftMissingColumn, expected.Name, expectedCol.Name, "", "")
+			continue
+		}
+
+		expectedType, err := checker.columnType(expectedCol, actualCol)
+		if err != nil {
+			return err
+		}
+
+		actualType, err := checker.columnType(actualCol, actualCol)
+		if err != nil {
+			return err
+		}
+
+		if !strings.EqualFold(expectedType, actualType) {
+			report.add(DriftColumnType, expected.Name, expectedCol.Name, expectedType, actualType)
+		}
+
+		if expectedCol.IsNullable != actualCol.IsNullable {
+			report.add(DriftColumnNullable, expected.Name, expectedCol.Name,
+				nullableString(expectedCol.IsNullable), nullableString(actualCol.IsNullable))
+		}
+	}
+
+	for _, actualCol := range actual.Columns {
+		if expected.findColumn(actualCol.Name) == nil {
+			report.add(DriftExtraColumn, expected.Name, actualCol.Name, "", "")
+		}
+	}
+
+	if !reflect.DeepEqual(normalizeColumnNames(expected.PrimaryKey), normalizeColumnNames(actual.PrimaryKey)) {
+		report.add(DriftPrimaryKey, expected.Name, "",
+			strings.Join(expected.PrimaryKey, ","), strings.Join(actual.PrimaryKey, ","))
+	}
+
+	for _, uk := range expected.UniqueKeys {
+		if !hasUniqueKey(actual.UniqueKeys, uk.ColumnNames) {
+			report.add(DriftMissingUniqueKey, expected.Name, strings.Join(uk.ColumnNames, ","), "", "")
+		}
+	}
+
+	//unique key also serve as index
+	for _, ik := range expected.Indices {
+		if !hasIndexKey(actual.Indices, ik.ColumnNames) && !hasUniqueKey(actual.UniqueKeys, ik.ColumnNames) {
+			report.add(DriftMissingIndexKey, expected.Name, strings.Join(ik.ColumnNames, ","), "", "")
+		}
+	}
+
+	for index := range expected.ForiegnKeys {
+		fk := &expected.ForiegnKeys[index]
+		if findForeignKey(actual, fk) < 0 {
+			columnNames, refColumnNames := []string{}, []string{}
+			for _, fkCol := range fk.Columns {
+				columnNames = append(columnNames, fkCol.ColumnName)
+				refColumnNames = append(refColumnNames, fkCol.RefColumnName)
+			}
+
+			report.add(DriftMissingForeignKey, expected.Name, strings.Join(columnNames, ","),
+				fk.ReferenceTableName+"("+strings.Join(refColumnNames, ",")+")", "")
+		}
+	}
+
+	return nil
+}
+
+//columnType render column data type with dialect; auto increment flag is ignored, so does
+//integer display width as long as integer size is same, example: int(11) and int(10) of
+//MySQL 8 (which drops display width) are same type while int(11) and bigint(20) are not
+func (checker *SchemaChecker) columnType(colDef *ColumnDefinition, actualCol *ColumnDefinition) (string, error) {
+	tmp := *colDef
+	tmp.IsAutoIncrement = false
+
+	if tmp.DataType == INTEGER && actualCol.DataType == INTEGER &&
+		columnIntegerSize(&tmp) == columnIntegerSize(actualCol) {
+		tmp.Length, tmp.IntegerSize = actualCol.Length, actualCol.IntegerSize
+	}
+
+	return checker.dialect.ColumnType(&tmp)
+}
+
+func nullableString(isNullable bool) string {
+	if isNullable {
+		return "NULL"
+	}
+
+	return "NOT NULL"
+}
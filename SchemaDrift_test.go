@@ -0,0 +1,115 @@
+//go:build cgo
+// +build cgo
+
+package rdbmstool
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSchemaChecker_Check(t *testing.T) {
+	db, cleanup := openSQLiteTestDB(t)
+	defer cleanup()
+
+	statements := []string{
+		"CREATE TABLE \"customer\"(\"id\" INTEGER NOT NULL PRIMARY KEY, \"name\" TEXT NOT NULL)",
+		"CREATE TABLE \"invoice\"(\"id\" INTEGER NOT NULL PRIMARY KEY, \"code\" TEXT NULL, " +
+			"\"amount\" TEXT NOT NULL, \"customer_id\" INTEGER NOT NULL, \"remark\" TEXT NULL)"}
+	for _, statement := range statements {
+		if _, err := db.Exec(statement); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	customer := NewTableBuilder().
+		TableName("customer").
+		AddColumnIntAutoIncrement("id", 11).
+		AddColumnVarchar("name", 100, false).
+		AddPrimaryKey("id")
+
+	invoice := NewTableBuilder().
+		TableName("invoice").
+		AddColumnInt("id", 11, false).
+		AddColumnVarchar("code", 20, false).
+		AddColumnDecimal("amount", 10, 2, false).
+		AddColumnInt("customer_id", 11, false).
+		AddColumnDate("issue_on", false).
+		AddPrimaryKey("id").
+		AddUniqueKey("code").
+		AddIndexKey("customer_id").
+		AddForeignKey("customer_id", "customer", "id")
+
+	payment := NewTableBuilder().
+		TableName("payment").
+		AddColumnInt("id", 11, false).
+		AddPrimaryKey("id")
+
+	checker := NewSchemaChecker(db, NewSQLiteMetaQuery(), NewSQLiteDialect()).
+		AddTable(customer, invoice, payment)
+
+	report, err := checker.Check("main")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "8 schema drift(s) found\n" +
+		"- invoice.code: column nullability mismatch (expected NOT NULL, actual NULL)\n" +
+		"- invoice.amount: column type mismatch (expected NUMERIC, actual TEXT)\n" +
+		"- invoice.issue_on: missing column\n" +
+		"- invoice.remark: extra column\n" +
+		"- invoice.code: missing unique key\n" +
+		"- invoice.customer_id: missing index key\n" +
+		"- invoice.customer_id: missing foreign key (expected customer(id))\n" +
+		"- payment: missing table"
+
+	if !report.HasDrift() {
+		t.Fatal("Expect drift found")
+	}
+
+	if strings.Compare(expected, report.Error()) != 0 {
+		t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expected, report.Error())
+	}
+
+	//database match definition in code
+	report, err = NewSchemaChecker(db, NewSQLiteMetaQuery(), NewSQLiteDialect()).
+		AddTable(customer).
+		Check("main")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if report.HasDrift() {
+		t.Errorf("Expect no drift but get:\n%s", report.Error())
+	}
+}
+
+func TestSchemaChecker_integerSize(t *testing.T) {
+	expected := NewTableBuilder().
+		TableName("invoice").
+		AddColumnInt("id", 11, false).
+		AddColumnInt("customer_id", 11, false).
+		AddColumnInt("qty", 6, false).
+		GetTableDefinition()
+
+	//id is MySQL 8 int without display width; customer_id is bigint(20)
+	actual := NewTableBuilder().
+		TableName("invoice").
+		AddColumnInt("id", 10, false).
+		AddColumnInt("customer_id", 20, false).
+		AddColumnInt("qty", 5, false).
+		GetTableDefinition()
+	actual.Columns[1].IntegerSize, actual.Columns[2].IntegerSize = 8, 2
+
+	checker := NewSchemaChecker(nil, NewMySQLMetaQuery(), NewMySQLDialect())
+	report := &DriftReport{Drifts: []Drift{}}
+	if err := checker.compareTable(report, expected, actual); err != nil {
+		t.Fatal(err)
+	}
+
+	expectedReport := "1 schema drift(s) found\n" +
+		"- invoice.customer_id: column type mismatch (expected int(11), actual bigint(20))"
+	if strings.Compare(expectedReport, report.Error()) != 0 {
+		t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expectedReport, report.Error())
+	}
+}
@@ -23,21 +23,48 @@ func (column *SelectColumnDefinition) SQL() (string, error) {
 
 //SelectDefinition SQL query definition
 type SelectDefinition struct {
-	Select  []SelectColumnDefinition
-	From    *FromDefinition
-	Join    []JoinDefinition
-	Where   *ConditionDefinition
-	GroupBy []GroupByDefinition
-	Having  *ConditionDefinition
-	OrderBy []OrderByDefinition
-	Limit   *LimitDefinition
-	Union   []SelectDefinition
+	Distinct bool
+	Select   []SelectColumnDefinition
+	From     *FromDefinition
+	Join     []JoinDefinition
+	Where    *ConditionDefinition
+	GroupBy  []GroupByDefinition
+	Having   *ConditionDefinition
+	OrderBy  []OrderByDefinition
+	Limit    *LimitDefinition
+	Union    []SelectDefinition
 }
 
-//SQL generate SQL string for SELECT statement
+//SQL generate SQL string for SELECT statement with default dialect
 func (query *SelectDefinition) SQL() (string, error) {
+	return query.SQLDialect(DefaultDialect())
+}
+
+//SQLDialect generate SQL string for SELECT statement with specified dialect
+func (query *SelectDefinition) SQLDialect(dialect Dialect) (string, error) {
+	return unboundSQL(query.Build(dialect))
+}
+
+//Build generate SQL string for SELECT statement and its bound arguments with specified dialect
+func (query *SelectDefinition) Build(dialect Dialect) (string, []interface{}, error) {
+	binder := newArgBinder(dialect)
+
+	sql, err := query.build(binder)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return sql, binder.args, nil
+}
+
+func (query *SelectDefinition) build(binder *argBinder) (string, error) {
+	dialect := binder.dialect
 	result := ""
 
+	if err := dialect.ValidateQuery(query); err != nil {
+		return "", err
+	}
+
 	//Column
 	if len(query.Select) == 0 {
 		return "", errors.New("Select column must atlest have one item to select")
@@ -48,7 +75,9 @@ func (query *SelectDefinition) SQL() (string, error) {
 			return "", fmt.Errorf("Failed to generate SELECT column (index %d) SQL string: %s", index, err.Error())
 		}
 
-		if index == 0 {
+		if index == 0 && query.Distinct {
+			result = "SELECT DISTINCT " + sql
+		} else if index == 0 {
 			result = "SELECT " + sql
 		} else {
 			result = result + ", " + sql
@@ -56,7 +85,7 @@ func (query *SelectDefinition) SQL() (string, error) {
 	}
 
 	//From
-	fromSQL, fromErr := query.From.SQL()
+	fromSQL, fromErr := query.From.build(binder)
 	if fromErr != nil {
 		return "", errors.New("Failed to generate FROM SQL string: " + fromErr.Error())
 	}
@@ -64,7 +93,7 @@ func (query *SelectDefinition) SQL() (string, error) {
 
 	//Join
 	for index, join := range query.Join {
-		joinSQL, joinErr := join.SQL()
+		joinSQL, joinErr := join.build(binder)
 		if joinErr != nil {
 			return "", fmt.Errorf("Failed to generate JOIN (index %d) SQL string: %s", index, joinErr.Error())
 		}
@@ -73,7 +102,7 @@ func (query *SelectDefinition) SQL() (string, error) {
 
 	//Where
 	if query.Where != nil {
-		whereSQL, whrErr := query.Where.String()
+		whereSQL, whrErr := query.Where.build(binder)
 		if whrErr != nil {
 			return "", errors.New("Failed to generate WHERE SQL string: " + whrErr.Error())
 		}
@@ -98,7 +127,7 @@ func (query *SelectDefinition) SQL() (string, error) {
 
 	//Having
 	if query.Having != nil {
-		havingSQL, haveErr := query.Having.String()
+		havingSQL, haveErr := query.Having.build(binder)
 		if haveErr != nil {
 			return "", fmt.Errorf("Failed to generate HAVING SQL string: %s", haveErr.Error())
 		}
@@ -123,7 +152,7 @@ func (query *SelectDefinition) SQL() (string, error) {
 
 	//Limit
 	if query.Limit != nil {
-		limitSQL, limitErr := query.Limit.SQL()
+		limitSQL, limitErr := query.Limit.SQLDialect(dialect)
 		if limitErr != nil {
 			return "", fmt.Errorf("Failed to generate LIMIT SQL string: %s", limitErr.Error())
 		}
@@ -133,13 +162,56 @@ func (query *SelectDefinition) SQL() (string, error) {
 	//Union
 	if len(query.Union) > 0 {
 		for index, q := range query.Union {
-			qSQL, qErr := q.SQL()
+			qSQL, qErr := q.build(binder)
 			if qErr != nil {
 				return "", fmt.Errorf("Failed to generate UNION (index %d) SQL string: %s", index, qErr.Error())
 			}
-			result = result + "\n" + qSQL
+			result = result + "\nUNION\n" + qSQL
 		}
 	}
 
 	return result, nil
 }
+
+//Validate check query definition integrity; return *ValidationError with all problems found
+func (query *SelectDefinition) Validate() error {
+	v := &validator{}
+
+	if len(query.Select) == 0 {
+		v.add("select", "query must atleast have one column to select")
+	}
+
+	for index, col := range query.Select {
+		if strings.Compare(col.Expression, "") == 0 {
+			v.add(fmt.Sprintf("select[%d]", index), "select column expression cannot be empty")
+		}
+	}
+
+	if query.From == nil {
+		v.add("from", "query must have FROM source")
+	} else {
+		v.merge("from", query.From.Validate())
+	}
+
+	for index := range query.Join {
+		v.merge(fmt.Sprintf("join[%d]", index), query.Join[index].Validate())
+	}
+
+	if query.Where != nil {
+		v.merge("where", query.Where.Validate())
+	}
+
+	if query.Having != nil {
+		v.merge("having", query.Having.Validate())
+	}
+
+	if query.Limit != nil && (query.Limit.RowCount < 0 || query.Limit.Offset < 0) {
+		v.add("limit", "row count and offset cannot be negative")
+	}
+
+	for index := range query.Union {
+		v.merge(fmt.Sprintf("union[%d]", index), query.Union[index].Validate())
+	}
+
+	return v.result()
+}
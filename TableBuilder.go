@@ -3,6 +3,7 @@ package rdbmstool
 //TableBuilder SQL create table statement builder
 type TableBuilder struct {
 	tableDefinition *TableDefinition
+	dialect         Dialect
 }
 
 //NewTableBuilder create new SQL table definition builder
@@ -14,7 +15,8 @@ func NewTableBuilder() *TableBuilder {
 			PrimaryKey:  []string{},
 			ForiegnKeys: []ForeignKeyDefinition{},
 			UniqueKeys:  []UniqueKeyDefinition{},
-			Indices:     []IndexKeyDefinition{}}}
+			Indices:     []IndexKeyDefinition{}},
+		dialect: DefaultDialect()}
 }
 
 //GetTableName get table name
@@ -22,9 +24,25 @@ func (builder *TableBuilder) GetTableName() string {
 	return builder.tableDefinition.Name
 }
 
+//GetTableDefinition get table definition
+func (builder *TableBuilder) GetTableDefinition() *TableDefinition {
+	return builder.tableDefinition
+}
+
+//Validate check table definition integrity
+func (builder *TableBuilder) Validate() error {
+	return builder.tableDefinition.Validate()
+}
+
 //SQL generate table definition SQL statement
 func (builder *TableBuilder) SQL() (string, error) {
-	return builder.tableDefinition.SQL()
+	return builder.tableDefinition.SQLDialect(builder.dialect)
+}
+
+//Dialect set SQL dialect used to generate SQL statement
+func (builder *TableBuilder) Dialect(dialect Dialect) *TableBuilder {
+	builder.dialect = dialect
+	return builder
 }
 
 //TableName set table name
@@ -118,6 +136,19 @@ func (builder *TableBuilder) AddColumnInt(columnName string, dataLength int, isN
 	return builder
 }
 
+//AddColumnIntAutoIncrement add auto increment integer column definition
+func (builder *TableBuilder) AddColumnIntAutoIncrement(columnName string, dataLength int) *TableBuilder {
+	builder.tableDefinition.Columns = append(builder.tableDefinition.Columns, ColumnDefinition{
+		Name:             columnName,
+		DataType:         INTEGER,
+		Length:           dataLength,
+		IsNullable:       false,
+		DecimalPrecision: 0,
+		IsAutoIncrement:  true})
+
+	return builder
+}
+
 //AddColumnChar add char column definition
 func (builder *TableBuilder) AddColumnChar(columnName string, dataLength int, isNullable bool) *TableBuilder {
 	builder.tableDefinition.Columns = append(builder.tableDefinition.Columns, ColumnDefinition{
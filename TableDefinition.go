@@ -50,6 +50,35 @@ func (colType ColumnDataType) String() string {
 	}
 }
 
+//integerSize storage size in byte implied by INTEGER column length; length is treated as MySQL
+//display width, example: tinyint(4), smallint(6), mediumint(9), int(11), bigint(20)
+func integerSize(length int) int {
+	switch {
+	case length <= 0:
+		return 4
+	case length <= 4:
+		return 1
+	case length <= 6:
+		return 2
+	case length <= 9:
+		return 3
+	case length <= 11:
+		return 4
+	default:
+		return 8
+	}
+}
+
+//columnIntegerSize storage size in byte of INTEGER column; implied by column length if the
+//column does not specify integer size
+func columnIntegerSize(colDef *ColumnDefinition) int {
+	if colDef.IntegerSize > 0 {
+		return colDef.IntegerSize
+	}
+
+	return integerSize(colDef.Length)
+}
+
 // TableDefinition is information to create a data table
 type TableDefinition struct {
 	Name        string
@@ -68,6 +97,8 @@ type ColumnDefinition struct {
 	Length           int
 	IsNullable       bool
 	DecimalPrecision int
+	IsAutoIncrement  bool //value generated by database (AUTO_INCREMENT, IDENTITY, etc.)
+	IntegerSize      int  //storage size in byte of INTEGER column other than int (1, 2, 3, or 8)
 }
 
 // ForeignKeyDefinition is information to create a RDBMS FK
@@ -98,25 +129,51 @@ type IndexKeyDefinition struct {
 	ColumnNames []string
 }
 
-//SQL to generate "create table" SQL statement
+//SQL to generate "create table" SQL statement with default dialect
 func (tableDef *TableDefinition) SQL() (string, error) {
+	return tableDef.SQLDialect(DefaultDialect())
+}
+
+//SQLDialect to generate "create table" SQL statement with specified dialect
+func (tableDef *TableDefinition) SQLDialect(dialect Dialect) (string, error) {
+	statements, err := tableDef.Statements(dialect)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Join(statements, "\n"), nil
+}
+
+//Statements generate CREATE TABLE statement follow by standalone CREATE INDEX statement(s)
+//(if dialect not support inline index); each statement can be executed separately
+func (tableDef *TableDefinition) Statements(dialect Dialect) ([]string, error) {
 	if tableDef == nil {
-		return "", errors.New("input parameter is null")
+		return nil, errors.New("input parameter is null")
 	}
 
-	//TODO: validate tableDef integrity
+	dialect = resolveDialect(dialect)
+
+	if err := tableDef.Validate(); err != nil {
+		return nil, err
+	}
 
 	//generate based on tableDef variable
 	var colSQL string
 	var tmpSQL string
 	var tmpErr error
 
+	inlinePK := dialect.InlinePrimaryKey(tableDef)
+
 	//generate column SQL statement
 	for index, col := range tableDef.Columns {
-		tmpSQL, tmpErr = tableDef.generateColumnSQL(&col)
+		tmpSQL, tmpErr = tableDef.generateColumnSQL(dialect, &col)
 
 		if tmpErr != nil {
-			return "", tmpErr
+			return nil, tmpErr
+		}
+
+		if inlinePK && strings.Compare(col.Name, tableDef.PrimaryKey[0]) == 0 {
+			tmpSQL = tmpSQL + " PRIMARY KEY"
 		}
 
 		if index == 0 {
@@ -127,19 +184,19 @@ func (tableDef *TableDefinition) SQL() (string, error) {
 	}
 
 	//generate PK SQL statement
-	pkSQL, pkErr := tableDef.generatePrimaryKeySQL()
+	pkSQL, pkErr := tableDef.generatePrimaryKeySQL(dialect)
 	if pkErr != nil {
-		return "", pkErr
+		return nil, pkErr
 	}
 
-	if pkSQL != "" {
+	if pkSQL != "" && !inlinePK {
 		colSQL = colSQL + ",\n" + pkSQL
 	}
 
 	//generate Unique key SQL statement
-	ukSQL, ukErr := tableDef.generateUniqueKeySQL()
+	ukSQL, ukErr := tableDef.generateUniqueKeySQL(dialect)
 	if ukErr != nil {
-		return "", ukErr
+		return nil, ukErr
 	}
 
 	if ukSQL != "" {
@@ -147,76 +204,51 @@ func (tableDef *TableDefinition) SQL() (string, error) {
 	}
 
 	//generate index key SQL statement
-	ikSQL, ikErr := tableDef.generateIndexSQL()
+	ikSQL, ikErr := tableDef.generateIndexSQL(dialect)
 	if ikErr != nil {
-		return "", ikErr
+		return nil, ikErr
 	}
 
-	if ikSQL != "" {
+	if ikSQL != "" && dialect.InlineIndex() {
 		colSQL = colSQL + ",\n" + ikSQL
 	}
 
 	//generate FK SQL statement
-	fkSQL, fkErr := tableDef.generateForeignKeySQL()
+	fkSQL, fkErr := tableDef.generateForeignKeySQL(dialect)
 	if fkErr != nil {
-		return "", fkErr
+		return nil, fkErr
 	}
 
 	if fkSQL != "" {
 		colSQL = colSQL + ",\n" + fkSQL
 	}
 
-	sqlStatement := fmt.Sprintf(
-		"CREATE TABLE `%s`(\n%s\n) ENGINE=innodb DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;",
-		tableDef.Name, colSQL)
+	tableOptions := dialect.TableOptions()
+	if tableOptions != "" {
+		tableOptions = " " + tableOptions
+	}
 
-	return sqlStatement, nil
-}
+	sqlStatement := fmt.Sprintf(
+		"CREATE TABLE %s(\n%s\n)%s;",
+		dialect.QuoteIdentifier(tableDef.Name), colSQL, tableOptions)
 
-func (tableDef *TableDefinition) generateColumnSQL(colDef *ColumnDefinition) (string, error) {
-	switch colDef.DataType {
-	case CHAR:
-		return fmt.Sprintf("`%s` char(%d) COLLATE %s %s",
-			colDef.Name, colDef.Length, collate, tableDef.generateIsNullSQL(colDef.IsNullable)), nil
-	case INTEGER:
-		return fmt.Sprintf("`%s` int(%d) %s",
-			colDef.Name, colDef.Length, tableDef.generateIsNullSQL(colDef.IsNullable)), nil
-	case DECIMAL:
-		return fmt.Sprintf("`%s` decimal(%d,%d) %s",
-			colDef.Name, colDef.Length, colDef.DecimalPrecision, tableDef.generateIsNullSQL(colDef.IsNullable)), nil
-	case FLOAT:
-		return fmt.Sprintf("`%s` float %s",
-			colDef.Name, tableDef.generateIsNullSQL(colDef.IsNullable)), nil
-	case TEXT:
-		return fmt.Sprintf("`%s` text COLLATE %s %s",
-			colDef.Name, collate, tableDef.generateIsNullSQL(colDef.IsNullable)), nil
-	case DATE:
-		return fmt.Sprintf("`%s` date %s",
-			colDef.Name, tableDef.generateIsNullSQL(colDef.IsNullable)), nil
-	case DATETIME:
-		return fmt.Sprintf("`%s` datetime %s",
-			colDef.Name, tableDef.generateIsNullSQL(colDef.IsNullable)), nil
-	case BOOLEAN:
-		return fmt.Sprintf("`%s` tinyint(1) %s", colDef.Name, tableDef.generateIsNullSQL(colDef.IsNullable)), nil
-	case VARCHAR:
-		return fmt.Sprintf("`%s` varchar(%d) COLLATE %s %s",
-			colDef.Name, colDef.Length, collate, tableDef.generateIsNullSQL(colDef.IsNullable)), nil
-	default:
-		return "", fmt.Errorf(
-			"unknown data column (%s) type: %d", colDef.Name, colDef.DataType)
-	}
-}
+	statements := []string{sqlStatement}
 
-func (tableDef *TableDefinition) generateIsNullSQL(isNullable bool) string {
-	if isNullable {
-		return "NULL"
+	//append standalone CREATE INDEX statement(s)
+	if !dialect.InlineIndex() {
+		for _, ik := range tableDef.Indices {
+			statements = append(statements, dialect.IndexKey(tableDef.Name, ik.ColumnNames))
+		}
 	}
 
-	return "NOT NULL"
+	return statements, nil
+}
 
+func (tableDef *TableDefinition) generateColumnSQL(dialect Dialect, colDef *ColumnDefinition) (string, error) {
+	return columnSQL(dialect, colDef)
 }
 
-func (tableDef *TableDefinition) generateIndexSQL() (string, error) {
+func (tableDef *TableDefinition) generateIndexSQL(dialect Dialect) (string, error) {
 	if tableDef == nil || tableDef.Indices == nil {
 		return "", errors.New("Index SQL generator: Cannot pass null parameter")
 	}
@@ -225,25 +257,18 @@ func (tableDef *TableDefinition) generateIndexSQL() (string, error) {
 	var tmpSQL string
 	length := len(tableDef.Indices)
 	if length > 0 {
-		for index, ik := range tableDef.Indices {
+		separator := ",\n"
+		if !dialect.InlineIndex() {
+			separator = "\n"
+		}
 
-			var uName string
-			var uCols string
-			for colIndex, colName := range ik.ColumnNames {
-				if colIndex == 0 {
-					uName = colName
-					uCols = "`" + colName + "`"
-				} else {
-					uName = uName + "_" + colName
-					uCols = uCols + ",`" + colName + "`"
-				}
-			}
-			tmpSQL = fmt.Sprintf("KEY `%s` (%s)", uName, uCols)
+		for index, ik := range tableDef.Indices {
+			tmpSQL = dialect.IndexKey(tableDef.Name, ik.ColumnNames)
 
 			if index == 0 {
 				sql = tmpSQL
 			} else {
-				sql = sql + ",\n" + tmpSQL
+				sql = sql + separator + tmpSQL
 			}
 		}
 	} else {
@@ -253,7 +278,7 @@ func (tableDef *TableDefinition) generateIndexSQL() (string, error) {
 	return sql, nil
 }
 
-func (tableDef *TableDefinition) generateUniqueKeySQL() (string, error) {
+func (tableDef *TableDefinition) generateUniqueKeySQL(dialect Dialect) (string, error) {
 	if tableDef == nil || tableDef.UniqueKeys == nil {
 		return "", errors.New("Unique Key SQL generator: Cannot pass null parameter")
 	}
@@ -263,19 +288,7 @@ func (tableDef *TableDefinition) generateUniqueKeySQL() (string, error) {
 	length := len(tableDef.UniqueKeys)
 	if length > 0 {
 		for index, uk := range tableDef.UniqueKeys {
-
-			var uName string
-			var uCols string
-			for colIndex, colName := range uk.ColumnNames {
-				if colIndex == 0 {
-					uName = colName
-					uCols = "`" + colName + "`"
-				} else {
-					uName = uName + "_" + colName
-					uCols = uCols + ",`" + colName + "`"
-				}
-			}
-			tmpSQL = fmt.Sprintf("UNIQUE KEY `%s` (%s)", uName, uCols)
+			tmpSQL = dialect.UniqueKey(tableDef.Name, uk.ColumnNames)
 
 			if index == 0 {
 				sql = tmpSQL
@@ -290,7 +303,7 @@ func (tableDef *TableDefinition) generateUniqueKeySQL() (string, error) {
 	return sql, nil
 }
 
-func (tableDef *TableDefinition) generateForeignKeySQL() (string, error) {
+func (tableDef *TableDefinition) generateForeignKeySQL(dialect Dialect) (string, error) {
 	if tableDef == nil || tableDef.ForiegnKeys == nil {
 		return "", errors.New("FK SQL generator: Cannot pass null parameter")
 	}
@@ -299,27 +312,9 @@ func (tableDef *TableDefinition) generateForeignKeySQL() (string, error) {
 	var tmpSQL string
 	length := len(tableDef.ForiegnKeys)
 	if length > 0 {
-		for index, fk := range tableDef.ForiegnKeys {
-			//build columns strings
-			baseCols := ""
-			refCols := ""
-			for colIndex, coll := range fk.Columns {
-				if colIndex == 0 {
-					baseCols = "`" + coll.ColumnName + "`"
-					refCols = "`" + coll.RefColumnName + "`"
-				} else {
-					baseCols += "," + "`" + coll.ColumnName + "`"
-					refCols += "," + "`" + coll.RefColumnName + "`"
-				}
-			}
-
-			if strings.Compare(fk.Name, "") == 0 {
-				tmpSQL = fmt.Sprintf("CONSTRAINT `%s_ibfk_%d` FOREIGN KEY (%s) REFERENCES `%s` (%s)",
-					tableDef.Name, index+1, baseCols,
-					fk.ReferenceTableName, refCols)
-			} else {
-				tmpSQL = fk.Name
-			}
+		for index := range tableDef.ForiegnKeys {
+			fk := &tableDef.ForiegnKeys[index]
+			tmpSQL = foreignKeySQL(dialect, foreignKeyName(tableDef.Name, index, fk), fk)
 
 			if index == 0 {
 				sql = tmpSQL
@@ -334,7 +329,7 @@ func (tableDef *TableDefinition) generateForeignKeySQL() (string, error) {
 	return sql, nil
 }
 
-func (tableDef *TableDefinition) generatePrimaryKeySQL() (string, error) {
+func (tableDef *TableDefinition) generatePrimaryKeySQL(dialect Dialect) (string, error) {
 	if tableDef == nil || tableDef.PrimaryKey == nil {
 		return "", errors.New("PK SQL generator: Cannot pass null parameter")
 	}
@@ -342,18 +337,185 @@ func (tableDef *TableDefinition) generatePrimaryKeySQL() (string, error) {
 	var sql string
 	length := len(tableDef.PrimaryKey)
 	if length > 0 {
-		for index, pk := range tableDef.PrimaryKey {
-			if index == 0 {
-				sql = "`" + pk + "`"
-			} else {
-				sql = sql + ",`" + pk + "`"
-			}
-		}
-
-		sql = fmt.Sprintf("PRIMARY KEY(%s)", sql)
+		sql = fmt.Sprintf("PRIMARY KEY(%s)", quoteIdentifiers(dialect, tableDef.PrimaryKey))
 	} else {
 		sql = ""
 	}
 
 	return sql, nil
 }
+
+//Validate check table definition integrity; return *ValidationError with all problems found
+func (tableDef *TableDefinition) Validate() error {
+	v := &validator{}
+
+	if strings.Compare(tableDef.Name, "") == 0 {
+		v.add("name", "table name cannot be empty")
+	}
+
+	if len(tableDef.Columns) == 0 {
+		v.add("columns", "table must atleast have one column")
+	}
+
+	for index, col := range tableDef.Columns {
+		field := fmt.Sprintf("column[%d](%s)", index, col.Name)
+
+		if strings.Compare(col.Name, "") == 0 {
+			v.add(field, "column name cannot be empty")
+		} else if tableDef.findColumn(col.Name) != &tableDef.Columns[index] {
+			v.add(field, "duplicate column name")
+		}
+
+		switch col.DataType {
+		case CHAR, VARCHAR:
+			if col.Length <= 0 {
+				v.add(field, "%s length must be greater than zero", col.DataType)
+			}
+		case DECIMAL:
+			if col.Length <= 0 {
+				v.add(field, "DECIMAL length must be greater than zero")
+			} else if col.DecimalPrecision > col.Length {
+				v.add(field, "DECIMAL precision (%d) cannot be greater than length (%d)",
+					col.DecimalPrecision, col.Length)
+			}
+		case INTEGER, FLOAT, DOUBLE, TEXT, DATE, DATETIME, BOOLEAN:
+		default:
+			v.add(field, "unknown data type: %d", col.DataType)
+		}
+
+		if col.IsAutoIncrement && col.DataType != INTEGER {
+			v.add(field, "only INTEGER column can be auto increment")
+		}
+	}
+
+	tableDef.validateKeyColumns(v, "primary key", tableDef.PrimaryKey)
+
+	for index, uk := range tableDef.UniqueKeys {
+		field := fmt.Sprintf("unique key[%d]", index)
+		if len(uk.ColumnNames) == 0 {
+			v.add(field, "unique key must atleast have one column")
+		}
+		tableDef.validateKeyColumns(v, field, uk.ColumnNames)
+	}
+
+	for index, ik := range tableDef.Indices {
+		field := fmt.Sprintf("index[%d]", index)
+		if len(ik.ColumnNames) == 0 {
+			v.add(field, "index key must atleast have one column")
+		}
+		tableDef.validateKeyColumns(v, field, ik.ColumnNames)
+	}
+
+	for index, fk := range tableDef.ForiegnKeys {
+		field := fmt.Sprintf("foreign key[%d]", index)
+
+		if strings.Compare(fk.ReferenceTableName, "") == 0 {
+			v.add(field, "reference table name cannot be empty")
+		}
+
+		if len(fk.Columns) == 0 {
+			v.add(field, "foreign key must atleast have one column")
+		}
+
+		for _, fkCol := range fk.Columns {
+			if tableDef.findColumn(fkCol.ColumnName) == nil {
+				v.add(field, "column (%s) not found", fkCol.ColumnName)
+			}
+
+			if strings.Compare(fkCol.RefColumnName, "") == 0 {
+				v.add(field, "reference column name of column (%s) cannot be empty", fkCol.ColumnName)
+			}
+		}
+	}
+
+	return v.result()
+}
+
+//validateKeyColumns check every key column is declared in table definition
+func (tableDef *TableDefinition) validateKeyColumns(v *validator, field string, columnNames []string) {
+	for _, colName := range columnNames {
+		if tableDef.findColumn(colName) == nil {
+			v.add(field, "column (%s) not found", colName)
+		}
+	}
+}
+
+//findColumn find column definition by name; return nil if not found
+func (tableDef *TableDefinition) findColumn(columnName string) *ColumnDefinition {
+	for index := range tableDef.Columns {
+		if strings.Compare(tableDef.Columns[index].Name, columnName) == 0 {
+			return &tableDef.Columns[index]
+		}
+	}
+
+	return nil
+}
+
+//keyName generate key name by joining column names with underscore
+func keyName(columnNames []string) string {
+	return strings.Join(columnNames, "_")
+}
+
+//uniqueKeyName generate named unique constraint name, example: account_email_key
+func uniqueKeyName(tableName string, columnNames []string) string {
+	return tableName + "_" + keyName(columnNames) + "_key"
+}
+
+//indexKeyName generate standalone index name, example: account_email_idx
+func indexKeyName(tableName string, columnNames []string) string {
+	return tableName + "_" + keyName(columnNames) + "_idx"
+}
+
+//foreignKeyName get foreign key name; default to <table>_ibfk_<n> if name is not specified
+func foreignKeyName(tableName string, index int, fk *ForeignKeyDefinition) string {
+	if strings.Compare(fk.Name, "") != 0 {
+		return fk.Name
+	}
+
+	return fmt.Sprintf("%s_ibfk_%d", tableName, index+1)
+}
+
+//foreignKeySQL generate CONSTRAINT ... FOREIGN KEY ... REFERENCES ... clause
+func foreignKeySQL(dialect Dialect, fkName string, fk *ForeignKeyDefinition) string {
+	baseCols := []string{}
+	refCols := []string{}
+	for _, coll := range fk.Columns {
+		baseCols = append(baseCols, coll.ColumnName)
+		refCols = append(refCols, coll.RefColumnName)
+	}
+
+	return fmt.Sprintf("CONSTRAINT %s FOREIGN KEY (%s) REFERENCES %s (%s)",
+		dialect.QuoteIdentifier(fkName),
+		quoteIdentifiers(dialect, baseCols),
+		dialect.QuoteIdentifier(fk.ReferenceTableName),
+		quoteIdentifiers(dialect, refCols))
+}
+
+//columnSQL generate data column SQL string, example: `name` varchar(100) NOT NULL
+func columnSQL(dialect Dialect, colDef *ColumnDefinition) (string, error) {
+	colType, colErr := dialect.ColumnType(colDef)
+	if colErr != nil {
+		return "", colErr
+	}
+
+	nullSQL := "NOT NULL"
+	if colDef.IsNullable {
+		nullSQL = "NULL"
+	}
+
+	return fmt.Sprintf("%s %s %s", dialect.QuoteIdentifier(colDef.Name), colType, nullSQL), nil
+}
+
+//quoteIdentifiers quote every identifiers and join them with comma
+func quoteIdentifiers(dialect Dialect, identifiers []string) string {
+	result := ""
+	for index, identifier := range identifiers {
+		if index == 0 {
+			result = dialect.QuoteIdentifier(identifier)
+		} else {
+			result = result + "," + dialect.QuoteIdentifier(identifier)
+		}
+	}
+
+	return result
+}
@@ -97,3 +97,43 @@ func TestSQL(t *testing.T) {
 			expectedSQL, sql)
 	}
 }
+
+func TestTableDefinition_Validate(t *testing.T) {
+	def := NewTableBuilder().
+		TableName("invoice").
+		AddColumnChar("id", 0, false).
+		AddColumnVarchar("id", 20, false).
+		AddColumnDecimal("amount", 5, 8, false).
+		AddPrimaryKey("id").
+		AddUniqueKey("code").
+		AddForeignKey("customer_id", "customer", "id").
+		GetTableDefinition()
+
+	err := def.Validate()
+	validationErr, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("expect *ValidationError but get %v", err)
+	}
+
+	expectedProblems := []string{
+		"column[0](id): CHAR length must be greater than zero",
+		"column[1](id): duplicate column name",
+		"column[2](amount): DECIMAL precision (8) cannot be greater than length (5)",
+		"unique key[0]: column (code) not found",
+		"foreign key[0]: column (customer_id) not found",
+	}
+
+	if len(validationErr.Problems) != len(expectedProblems) {
+		t.Fatalf("expect %d problems but get:\n%s", len(expectedProblems), err.Error())
+	}
+
+	for index, problem := range validationErr.Problems {
+		if strings.Compare(expectedProblems[index], problem.String()) != 0 {
+			t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expectedProblems[index], problem.String())
+		}
+	}
+
+	if _, err := def.SQL(); err == nil {
+		t.Errorf("expect SQL generation fail on invalid table definition")
+	}
+}
@@ -0,0 +1,291 @@
+package rdbmstool
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/guinso/rdbmstool/parser"
+)
+
+//ParseTableDefinition parse CREATE TABLE statement into table definition with default dialect
+func ParseTableDefinition(sql string) (*TableDefinition, error) {
+	return ParseTableDefinitionDialect(sql, DefaultDialect())
+}
+
+//ParseTableDefinitionDialect parse CREATE TABLE statement written in specified dialect into
+//table definition
+func ParseTableDefinitionDialect(sql string, dialect Dialect) (*TableDefinition, error) {
+	ast, err := parseSQL(sql, dialect)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTableDefinitionFromSyntaxTree(ast)
+}
+
+//NewTableBuilderFromSQL parse CREATE TABLE statement into table builder so that it can be
+//modified and regenerated
+func NewTableBuilderFromSQL(sql string) (*TableBuilder, error) {
+	tableDef, err := ParseTableDefinition(sql)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := NewTableBuilder()
+	builder.tableDefinition = tableDef
+
+	return builder, nil
+}
+
+//NewTableDefinitionFromSyntaxTree convert parsed CREATE TABLE statement (NodeCreateTable)
+//into table definition; column default value, comment and table options are ignored
+func NewTableDefinitionFromSyntaxTree(ast *parser.SyntaxTree) (*TableDefinition, error) {
+	if ast.DataType != parser.NodeCreateTable {
+		return nil, fmt.Errorf("syntax tree node type (%d) is not a CREATE TABLE statement", ast.DataType)
+	}
+
+	tableDef := NewTableBuilder().GetTableDefinition()
+
+	for index := range ast.ChildNodes {
+		node := &ast.ChildNodes[index]
+
+		switch node.DataType {
+		case parser.NodeSource:
+			//schema name is not part of table definition
+			tableDef.Name = parser.UnquoteIdentifier(node.Source[node.EndPosition].Value)
+		case parser.NodeColumnDefinition:
+			if err := convertColumnDefinition(tableDef, node); err != nil {
+				return nil, fmt.Errorf("table (%s) %s", tableDef.Name, err.Error())
+			}
+		case parser.NodePrimaryKey:
+			tableDef.PrimaryKey = keyColumnNames(node)
+		case parser.NodeUniqueKey:
+			tableDef.UniqueKeys = append(tableDef.UniqueKeys, UniqueKeyDefinition{
+				ColumnNames: keyColumnNames(node)})
+		case parser.NodeIndexKey:
+			tableDef.Indices = append(tableDef.Indices, IndexKeyDefinition{
+				ColumnNames: keyColumnNames(node)})
+		case parser.NodeForeignKey:
+			fk, err := convertForeignKey(node, "")
+			if err != nil {
+				return nil, fmt.Errorf("table (%s) %s", tableDef.Name, err.Error())
+			}
+			tableDef.ForiegnKeys = append(tableDef.ForiegnKeys, *fk)
+		case parser.NodeTableOption:
+			//table options (engine, charset, etc.) are decided by dialect
+		case parser.NodeCreateOption:
+			//OR REPLACE, TEMPORARY and IF NOT EXISTS are decided when table is created
+		default:
+			return nil, fmt.Errorf("unsupported CREATE TABLE node type (%d)", node.DataType)
+		}
+	}
+
+	//primary key column is implicitly not nullable
+	for index := range tableDef.Columns {
+		if containsString(tableDef.PrimaryKey, tableDef.Columns[index].Name) {
+			tableDef.Columns[index].IsNullable = false
+		}
+	}
+
+	return tableDef, nil
+}
+
+//convertColumnDefinition append column into table definition; inline primary key,
+//unique key and foreign key are registered as table constraint
+func convertColumnDefinition(tableDef *TableDefinition, ast *parser.SyntaxTree) error {
+	colDef := ColumnDefinition{
+		Name:       parser.UnquoteIdentifier(ast.Source[ast.StartPosition].Value),
+		IsNullable: true}
+
+	if err := convertDataType(&colDef, &ast.ChildNodes[1]); err != nil {
+		return fmt.Errorf("column (%s): %s", colDef.Name, err.Error())
+	}
+
+	for index := 2; index < len(ast.ChildNodes); index++ {
+		node := &ast.ChildNodes[index]
+
+		if node.DataType == parser.NodeForeignKey {
+			fk, err := convertForeignKey(node, colDef.Name)
+			if err != nil {
+				return fmt.Errorf("column (%s) %s", colDef.Name, err.Error())
+			}
+			tableDef.ForiegnKeys = append(tableDef.ForiegnKeys, *fk)
+			continue
+		}
+
+		option := strings.ToLower(node.Text())
+		switch {
+		case strings.Compare(option, "not null") == 0:
+			colDef.IsNullable = false
+		case strings.Compare(option, "null") == 0:
+			colDef.IsNullable = true
+		case strings.Compare(option, "auto_increment") == 0,
+			strings.Compare(option, "autoincrement") == 0,
+			strings.HasPrefix(option, "identity"):
+			colDef.IsAutoIncrement = true
+		case strings.HasPrefix(option, "primary key"):
+			tableDef.PrimaryKey = []string{colDef.Name}
+		case strings.HasPrefix(option, "unique"):
+			tableDef.UniqueKeys = append(tableDef.UniqueKeys, UniqueKeyDefinition{
+				ColumnNames: []string{colDef.Name}})
+		}
+	}
+
+	tableDef.Columns = append(tableDef.Columns, colDef)
+
+	return nil
+}
+
+//convertDataType map declared data type onto ColumnDataType
+func convertDataType(colDef *ColumnDefinition, ast *parser.SyntaxTree) error {
+	words := []string{}
+	params := []int{}
+
+	for index := ast.StartPosition; index <= ast.EndPosition; index++ {
+		item := ast.Source[index]
+
+		if item.Type == parser.TokenNumber {
+			param, err := strconv.Atoi(item.Value)
+			if err != nil {
+				return fmt.Errorf("invalid data type parameter: %s", item.Value)
+			}
+			params = append(params, param)
+		} else if item.Type == parser.TokenLiteral {
+			word := strings.ToLower(item.Value)
+			if strings.Compare(word, "unsigned") != 0 &&
+				strings.Compare(word, "signed") != 0 &&
+				strings.Compare(word, "zerofill") != 0 {
+				words = append(words, word)
+			}
+		} else if item.Type == parser.TokenString {
+			return fmt.Errorf("unsupported data type: %s", ast.Text())
+		}
+	}
+
+	length, precision := 0, 0
+	if len(params) > 0 {
+		length = params[0]
+	}
+	if len(params) > 1 {
+		precision = params[1]
+	}
+
+	switch strings.Join(words, " ") {
+	case "char", "character", "nchar":
+		colDef.DataType, colDef.Length = CHAR, defaultLength(length, 1)
+	case "varchar", "character varying", "nvarchar", "varchar2":
+		if length > 0 {
+			colDef.DataType, colDef.Length = VARCHAR, length
+		} else {
+			colDef.DataType = TEXT
+		}
+	case "tinyint":
+		if length == 1 {
+			colDef.DataType = BOOLEAN
+		} else {
+			colDef.DataType, colDef.Length, colDef.IntegerSize = INTEGER, defaultLength(length, 4), 1
+		}
+	case "smallint", "int2":
+		colDef.DataType, colDef.Length, colDef.IntegerSize = INTEGER, defaultLength(length, 6), 2
+	case "mediumint":
+		colDef.DataType, colDef.Length, colDef.IntegerSize = INTEGER, defaultLength(length, 9), 3
+	case "int", "integer", "int4":
+		colDef.DataType, colDef.Length = INTEGER, defaultLength(length, 11)
+	case "bigint", "int8":
+		colDef.DataType, colDef.Length, colDef.IntegerSize = INTEGER, defaultLength(length, 20), 8
+	case "smallserial":
+		colDef.DataType, colDef.Length, colDef.IntegerSize, colDef.IsAutoIncrement = INTEGER, 6, 2, true
+	case "serial":
+		colDef.DataType, colDef.Length, colDef.IsAutoIncrement = INTEGER, 11, true
+	case "bigserial":
+		colDef.DataType, colDef.Length, colDef.IntegerSize, colDef.IsAutoIncrement = INTEGER, 20, 8, true
+	case "decimal", "numeric", "dec":
+		colDef.DataType, colDef.Length, colDef.DecimalPrecision = DECIMAL, defaultLength(length, 10), precision
+	case "float", "float4":
+		colDef.DataType = FLOAT
+	case "double", "double precision", "real", "float8":
+		colDef.DataType = DOUBLE
+	case "tinytext", "text", "mediumtext", "longtext", "ntext", "clob":
+		colDef.DataType = TEXT
+	case "date":
+		colDef.DataType = DATE
+	case "datetime", "datetime2", "timestamp", "timestamptz",
+		"timestamp with time zone", "timestamp without time zone":
+		colDef.DataType = DATETIME
+	case "bit", "bool", "boolean":
+		colDef.DataType = BOOLEAN
+	default:
+		return fmt.Errorf("unsupported data type: %s", ast.Text())
+	}
+
+	return nil
+}
+
+//convertForeignKey convert NodeForeignKey into foreign key definition; columnName is
+//referencing column of inline REFERENCES (column option)
+func convertForeignKey(ast *parser.SyntaxTree, columnName string) (*ForeignKeyDefinition, error) {
+	fk := &ForeignKeyDefinition{Columns: []FKColumnDefinition{}}
+	columnNames := []string{columnName}
+	lists := [][]string{}
+
+	for index := range ast.ChildNodes {
+		node := &ast.ChildNodes[index]
+
+		switch node.DataType {
+		case parser.NodeIdentifier:
+			fk.Name = parser.UnquoteIdentifier(node.Source[node.StartPosition].Value)
+		case parser.NodeSource:
+			fk.ReferenceTableName = parser.UnquoteIdentifier(node.Source[node.EndPosition].Value)
+		case parser.NodeList:
+			lists = append(lists, listColumnNames(node))
+		}
+	}
+
+	refColumnNames := lists[len(lists)-1]
+	if len(lists) > 1 {
+		columnNames = lists[0]
+	}
+
+	if len(columnNames) != len(refColumnNames) {
+		return nil, fmt.Errorf("foreign key to table (%s) has %d column(s) but reference %d column(s)",
+			fk.ReferenceTableName, len(columnNames), len(refColumnNames))
+	}
+
+	for index := range columnNames {
+		fk.Columns = append(fk.Columns, FKColumnDefinition{
+			ColumnName:    columnNames[index],
+			RefColumnName: refColumnNames[index]})
+	}
+
+	return fk, nil
+}
+
+//keyColumnNames get column names of key constraint node
+func keyColumnNames(ast *parser.SyntaxTree) []string {
+	for index := range ast.ChildNodes {
+		if ast.ChildNodes[index].DataType == parser.NodeList {
+			return listColumnNames(&ast.ChildNodes[index])
+		}
+	}
+
+	return []string{}
+}
+
+//listColumnNames get column names of NodeList; prefix length and sort order are ignored
+func listColumnNames(ast *parser.SyntaxTree) []string {
+	result := []string{}
+	for _, col := range ast.ChildNodes {
+		result = append(result, parser.UnquoteIdentifier(col.Source[col.StartPosition].Value))
+	}
+
+	return result
+}
+
+func defaultLength(length int, defaultValue int) int {
+	if length > 0 {
+		return length
+	}
+
+	return defaultValue
+}
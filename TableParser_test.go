@@ -0,0 +1,161 @@
+package rdbmstool
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseTableDefinition(t *testing.T) {
+	sql := "CREATE TABLE `account_role`(\n" +
+		"`id` char(100) COLLATE utf8mb4_unicode_ci NOT NULL,\n" +
+		"`account_id` char(100) COLLATE utf8mb4_unicode_ci NOT NULL,\n" +
+		"`role_id` char(100) COLLATE utf8mb4_unicode_ci NOT NULL,\n" +
+		"`score` decimal(10,2) NULL,\n" +
+		"`is_active` tinyint(1) NOT NULL,\n" +
+		"PRIMARY KEY(`id`),\n" +
+		"UNIQUE KEY `account_id_role_id` (`account_id`,`role_id`),\n" +
+		"KEY `account_id` (`account_id`),\n" +
+		"KEY `role_id` (`role_id`),\n" +
+		"CONSTRAINT `account_role_ibfk_1` FOREIGN KEY (`account_id`) REFERENCES `account` (`id`),\n" +
+		"CONSTRAINT `account_role_ibfk_2` FOREIGN KEY (`role_id`) REFERENCES `role` (`id`)\n" +
+		") ENGINE=innodb DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;"
+
+	builder, err := NewTableBuilderFromSQL(sql)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := builder.SQL()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Compare(result, sql) != 0 {
+		t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", sql, result)
+	}
+}
+
+func TestParseTableDefinition_dump(t *testing.T) {
+	tableDef, err := ParseTableDefinition("CREATE TABLE IF NOT EXISTS shop.`invoice` (\n" +
+		"  `id` int(10) unsigned NOT NULL AUTO_INCREMENT,\n" +
+		"  `code` varchar(20) CHARACTER SET utf8mb4 NOT NULL COMMENT 'invoice number',\n" +
+		"  `customer_id` int(10) unsigned NOT NULL,\n" +
+		"  `branch_id` int(10) unsigned NOT NULL,\n" +
+		"  `amount` decimal(12,2) NOT NULL DEFAULT -1.00,\n" +
+		"  `remark` text,\n" +
+		"  `created_on` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,\n" +
+		"  PRIMARY KEY (`id`) USING BTREE,\n" +
+		"  UNIQUE KEY `code` (`code`),\n" +
+		"  KEY `idx_remark` (`remark`(20), `created_on` DESC),\n" +
+		"  CONSTRAINT `fk_invoice_customer` FOREIGN KEY (`customer_id`, `branch_id`) " +
+		"REFERENCES `customer` (`id`, `branch_id`) ON DELETE CASCADE ON UPDATE NO ACTION\n" +
+		") ENGINE=InnoDB AUTO_INCREMENT=5 DEFAULT CHARSET=utf8mb4")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := &TableDefinition{
+		Name: "invoice",
+		Columns: []ColumnDefinition{
+			{Name: "id", DataType: INTEGER, Length: 10, IsAutoIncrement: true},
+			{Name: "code", DataType: VARCHAR, Length: 20},
+			{Name: "customer_id", DataType: INTEGER, Length: 10},
+			{Name: "branch_id", DataType: INTEGER, Length: 10},
+			{Name: "amount", DataType: DECIMAL, Length: 12, DecimalPrecision: 2},
+			{Name: "remark", DataType: TEXT, IsNullable: true},
+			{Name: "created_on", DataType: DATETIME}},
+		PrimaryKey: []string{"id"},
+		ForiegnKeys: []ForeignKeyDefinition{{
+			Name:               "fk_invoice_customer",
+			ReferenceTableName: "customer",
+			Columns: []FKColumnDefinition{
+				{ColumnName: "customer_id", RefColumnName: "id"},
+				{ColumnName: "branch_id", RefColumnName: "branch_id"}}}},
+		UniqueKeys: []UniqueKeyDefinition{{ColumnNames: []string{"code"}}},
+		Indices:    []IndexKeyDefinition{{ColumnNames: []string{"remark", "created_on"}}}}
+
+	if !reflect.DeepEqual(tableDef, expected) {
+		t.Errorf("Expect:\n%+v\n\nbut get:\n\n%+v", expected, tableDef)
+	}
+}
+
+func TestParseTableDefinition_integerSize(t *testing.T) {
+	tableDef, err := ParseTableDefinition("CREATE TABLE `stock` (\n" +
+		"`id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,\n" +
+		"`qty` smallint NOT NULL,\n" +
+		"`level` tinyint(3) NOT NULL,\n" +
+		"`seq` int(5) NOT NULL,\n" +
+		"PRIMARY KEY (`id`)\n" +
+		")")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sql, err := tableDef.SQL()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedSQL := "CREATE TABLE `stock`(\n" +
+		"`id` bigint(20) AUTO_INCREMENT NOT NULL,\n" +
+		"`qty` smallint(6) NOT NULL,\n" +
+		"`level` tinyint(3) NOT NULL,\n" +
+		"`seq` int(5) NOT NULL,\n" +
+		"PRIMARY KEY(`id`)\n" +
+		") ENGINE=innodb DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;"
+
+	if strings.Compare(expectedSQL, sql) != 0 {
+		t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expectedSQL, sql)
+	}
+}
+
+func TestParseTableDefinition_inlineConstraint(t *testing.T) {
+	sql := "CREATE TABLE \"member_role\" (\n" +
+		"\"id\" INTEGER PRIMARY KEY AUTOINCREMENT,\n" +
+		"\"member_id\" bigint NOT NULL REFERENCES \"member\" (\"id\") ON DELETE SET NULL,\n" +
+		"\"code\" character varying(30) UNIQUE,\n" +
+		"\"rate\" double precision,\n" +
+		"\"joined_at\" timestamp with time zone DEFAULT now()\n" +
+		")"
+
+	tableDef, err := ParseTableDefinitionDialect(sql, NewPostgreSQLDialect())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := &TableDefinition{
+		Name: "member_role",
+		Columns: []ColumnDefinition{
+			{Name: "id", DataType: INTEGER, Length: 11, IsAutoIncrement: true},
+			{Name: "member_id", DataType: INTEGER, Length: 20, IntegerSize: 8},
+			{Name: "code", DataType: VARCHAR, Length: 30, IsNullable: true},
+			{Name: "rate", DataType: DOUBLE, IsNullable: true},
+			{Name: "joined_at", DataType: DATETIME, IsNullable: true}},
+		PrimaryKey: []string{"id"},
+		ForiegnKeys: []ForeignKeyDefinition{{
+			ReferenceTableName: "member",
+			Columns:            []FKColumnDefinition{{ColumnName: "member_id", RefColumnName: "id"}}}},
+		UniqueKeys: []UniqueKeyDefinition{{ColumnNames: []string{"code"}}},
+		Indices:    []IndexKeyDefinition{}}
+
+	if !reflect.DeepEqual(tableDef, expected) {
+		t.Errorf("Expect:\n%+v\n\nbut get:\n\n%+v", expected, tableDef)
+	}
+
+	invalidSQLs := []string{
+		"CREATE TABLE a (id int NOT)",
+		"CREATE TABLE a (id geometry)",
+		"CREATE TABLE a (id int, PRIMARY KEY)",
+		"CREATE TABLE a (id int, FOREIGN KEY (id) REFERENCES b)",
+		"CREATE TABLE a (id int, FOREIGN KEY (id) REFERENCES b (x, y))",
+		"CREATE TABLE a (id int",
+		"CREATE TABLE \"a\" (id int)", //double quoted text is string in MySQL
+		"CREATE VIEW a AS SELECT b FROM c"}
+
+	for _, sql := range invalidSQLs {
+		if _, err := ParseTableDefinition(sql); err == nil {
+			t.Errorf("Expect error for: %s", sql)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package rdbmstool
+
+import (
+	"strings"
+)
+
+//UpdateBuilder SQL UPDATE statement builder
+type UpdateBuilder struct {
+	updateDefinition *UpdateDefinition
+	dialect          Dialect
+}
+
+//NewUpdateBuilder create new UPDATE SQL string builder
+func NewUpdateBuilder() *UpdateBuilder {
+	return &UpdateBuilder{
+		updateDefinition: &UpdateDefinition{
+			Table:        "",
+			Alias:        "",
+			Set:          nil,
+			Join:         nil,
+			Where:        nil,
+			AllowAllRows: false,
+		},
+		dialect: DefaultDialect()}
+}
+
+//Dialect set SQL dialect used to generate SQL string
+func (builder *UpdateBuilder) Dialect(dialect Dialect) *UpdateBuilder {
+	builder.dialect = dialect
+	return builder
+}
+
+//Table set target table name and its alias (optional)
+func (builder *UpdateBuilder) Table(tableName string, alias string) *UpdateBuilder {
+	builder.updateDefinition.Table = tableName
+	builder.updateDefinition.Alias = alias
+	return builder
+}
+
+//Set append SET column = value; value is bound as argument
+func (builder *UpdateBuilder) Set(column string, value interface{}) *UpdateBuilder {
+	builder.updateDefinition.Set = append(builder.updateDefinition.Set, SetDefinition{
+		Column: column,
+		Value:  value})
+	return builder
+}
+
+//SetExpression append SET column = expression; expression is raw SQL string
+func (builder *UpdateBuilder) SetExpression(column string, expression string) *UpdateBuilder {
+	builder.updateDefinition.Set = append(builder.updateDefinition.Set, SetDefinition{
+		Column: column,
+		Value:  Expression(expression)})
+	return builder
+}
+
+//SetClear clear all SET columns
+func (builder *UpdateBuilder) SetClear() *UpdateBuilder {
+	builder.updateDefinition.Set = nil
+	return builder
+}
+
+//Join set simple Join statement
+func (builder *UpdateBuilder) Join(source string, alias string, joinType JoinType,
+	condition string) *UpdateBuilder {
+
+	builder.updateDefinition.Join = []JoinDefinition{
+		*NewJoinDefinition(source, alias, joinType, condition)}
+
+	return builder
+}
+
+//JoinAdd append simple Join statement
+func (builder *UpdateBuilder) JoinAdd(source string, alias string, joinType JoinType,
+	condition string) *UpdateBuilder {
+
+	builder.updateDefinition.Join = append(builder.updateDefinition.Join,
+		*NewJoinDefinition(source, alias, joinType, condition))
+
+	return builder
+}
+
+//JoinComplexAdd append join statement
+func (builder *UpdateBuilder) JoinComplexAdd(join *JoinDefinition) *UpdateBuilder {
+	builder.updateDefinition.Join = append(builder.updateDefinition.Join, *join)
+	return builder
+}
+
+//Where set Where condition with simple expression string
+func (builder *UpdateBuilder) Where(condition string) *UpdateBuilder {
+	if strings.Compare(condition, "") == 0 {
+		builder.updateDefinition.Where = nil
+	} else if builder.updateDefinition.Where == nil {
+		builder.updateDefinition.Where = NewCondition(condition)
+	} else {
+		builder.updateDefinition.Where.SetCondition(condition)
+	}
+
+	return builder
+}
+
+//WhereAddAnd append AND Where condition with simple expression string
+func (builder *UpdateBuilder) WhereAddAnd(condition string) *UpdateBuilder {
+	return builder.WhereAddAndArgs(condition)
+}
+
+//WhereAddOr append OR Where condition with simple expression string
+func (builder *UpdateBuilder) WhereAddOr(condition string) *UpdateBuilder {
+	return builder.WhereAddOrArgs(condition)
+}
+
+//WhereAddAndArgs append AND Where condition with ? placeholder(s) and its bound argument(s)
+func (builder *UpdateBuilder) WhereAddAndArgs(condition string, args ...interface{}) *UpdateBuilder {
+	if builder.updateDefinition.Where == nil {
+		builder.updateDefinition.Where = NewConditionArgs(condition, args...)
+	} else {
+		builder.updateDefinition.Where.AddAndArgs(condition, args...)
+	}
+
+	return builder
+}
+
+//WhereAddOrArgs append OR Where condition with ? placeholder(s) and its bound argument(s)
+func (builder *UpdateBuilder) WhereAddOrArgs(condition string, args ...interface{}) *UpdateBuilder {
+	if builder.updateDefinition.Where == nil {
+		builder.updateDefinition.Where = NewConditionArgs(condition, args...)
+	} else {
+		builder.updateDefinition.Where.AddOrArgs(condition, args...)
+	}
+
+	return builder
+}
+
+//WhereEqual append AND "expression = value" Where condition
+func (builder *UpdateBuilder) WhereEqual(expression string, value interface{}) *UpdateBuilder {
+	cond := NewConditionEqual(expression, value)
+	return builder.WhereAddAndArgs(cond.Condition, cond.Args...)
+}
+
+//WhereIn append AND "expression IN (values...)" Where condition
+func (builder *UpdateBuilder) WhereIn(expression string, values ...interface{}) *UpdateBuilder {
+	cond := NewConditionIn(expression, values...)
+	return builder.WhereAddAndArgs(cond.Condition, cond.Args...)
+}
+
+//WhereComplex set where condition with ConditionDefinition
+func (builder *UpdateBuilder) WhereComplex(conditionDef *ConditionDefinition) *UpdateBuilder {
+	builder.updateDefinition.Where = conditionDef
+	return builder
+}
+
+//WhereAddComplex append where condition with ConditionDefinition
+func (builder *UpdateBuilder) WhereAddComplex(operator ConditionOperator,
+	conditionDef *ConditionDefinition) *UpdateBuilder {
+
+	if builder.updateDefinition.Where == nil {
+		builder.updateDefinition.Where = conditionDef
+	} else {
+		builder.updateDefinition.Where.AddComplex(operator, conditionDef)
+	}
+
+	return builder
+}
+
+//WhereClear clear WHERE statement
+func (builder *UpdateBuilder) WhereClear() *UpdateBuilder {
+	builder.updateDefinition.Where = nil
+	return builder
+}
+
+//AllRows explicitly allow UPDATE statement without WHERE condition
+func (builder *UpdateBuilder) AllRows(allow bool) *UpdateBuilder {
+	builder.updateDefinition.AllowAllRows = allow
+	return builder
+}
+
+//Validate check UPDATE definition integrity
+func (builder *UpdateBuilder) Validate() error {
+	return builder.updateDefinition.Validate()
+}
+
+//SQL generate SQL string
+func (builder *UpdateBuilder) SQL() (string, error) {
+	return builder.updateDefinition.SQLDialect(builder.dialect)
+}
+
+//Build generate SQL string with dialect placeholders and its bound arguments;
+//output is ready to pass into DbHandlerProxy.Exec(sql, args...)
+func (builder *UpdateBuilder) Build() (string, []interface{}, error) {
+	return builder.updateDefinition.Build(builder.dialect)
+}
@@ -0,0 +1,142 @@
+package rdbmstool
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/guinso/rdbmstool/parser"
+)
+
+func TestUpdateBuilder_Build(t *testing.T) {
+	builder := NewUpdateBuilder().
+		Table("member", "").
+		Set("name", "john").
+		SetExpression("updated_on", "CURRENT_TIMESTAMP").
+		WhereEqual("id", 7)
+
+	sql, args, err := builder.Build()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	expectedSQL := "UPDATE member\n" +
+		"SET name = ?, updated_on = CURRENT_TIMESTAMP\n" +
+		"WHERE id = ?"
+	if strings.Compare(expectedSQL, sql) != 0 {
+		t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expectedSQL, sql)
+	}
+	if len(args) != 2 || args[0] != "john" || args[1] != 7 {
+		t.Errorf("unexpected bound arguments: %v", args)
+	}
+
+	if _, _, err := builder.WhereClear().Build(); err == nil {
+		t.Errorf("expect error since UPDATE without WHERE is not explicitly allowed")
+	}
+
+	if _, _, err := builder.AllRows(true).Build(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUpdateBuilder_Join(t *testing.T) {
+	builder := NewUpdateBuilder().
+		Table("invoice", "a").
+		Join("customer", "b", InnerJoin, "a.customer_id = b.id").
+		Set("is_vip", true).
+		WhereAddAndArgs("b.score > ?", 100)
+
+	testCases := []struct {
+		dialect     Dialect
+		expectedSQL string
+	}{
+		{NewMySQLDialect(),
+			"UPDATE invoice AS a\n" +
+				"INNER JOIN customer AS b ON a.customer_id = b.id\n" +
+				"SET is_vip = ?\n" +
+				"WHERE b.score > ?"},
+		{NewPostgreSQLDialect(),
+			"UPDATE invoice AS a\n" +
+				"SET is_vip = $1\n" +
+				"FROM customer AS b\n" +
+				"WHERE a.customer_id = b.id AND (b.score > $2)"},
+		{NewSQLServerDialect(),
+			"UPDATE a\n" +
+				"SET is_vip = @p1\n" +
+				"FROM invoice AS a\n" +
+				"INNER JOIN customer AS b ON a.customer_id = b.id\n" +
+				"WHERE b.score > @p2"},
+	}
+
+	for _, testCase := range testCases {
+		sql, args, err := builder.Dialect(testCase.dialect).Build()
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+
+		if strings.Compare(testCase.expectedSQL, sql) != 0 {
+			t.Errorf("%s dialect expect:\n%s\n\nbut get:\n\n%s",
+				testCase.dialect.Name(), testCase.expectedSQL, sql)
+		}
+
+		if len(args) != 2 || args[0] != true || args[1] != 100 {
+			t.Errorf("%s dialect unexpected bound arguments: %v", testCase.dialect.Name(), args)
+		}
+	}
+}
+
+func TestUpdateBuilder_parse(t *testing.T) {
+	builder := NewUpdateBuilder().
+		Table("invoice", "a").
+		Join("customer", "b", InnerJoin, "a.customer_id = b.id").
+		Set("is_vip", true).
+		WhereAddAndArgs("b.score > ?", 100)
+
+	for _, dialect := range []Dialect{NewMySQLDialect(), NewPostgreSQLDialect(), NewSQLiteDialect(), NewSQLServerDialect()} {
+		sql, _, err := builder.Dialect(dialect).Build()
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+
+		if _, err := parser.ParseSQL(sql); err != nil {
+			t.Errorf("%s dialect fail to parse:\n%s\n\n%s", dialect.Name(), sql, err.Error())
+		}
+	}
+}
+
+func TestUpdateBuilder_blankWhere(t *testing.T) {
+	builders := []*UpdateBuilder{
+		NewUpdateBuilder().Table("member", "").Set("name", "john").Where("  "),
+		NewUpdateBuilder().Table("member", "").Set("name", "john").WhereComplex(&ConditionDefinition{}),
+		NewUpdateBuilder().Table("member", "").Set("name", "john").
+			WhereComplex(NewCondition("").AddAndComplex(NewCondition(" ")))}
+
+	for _, builder := range builders {
+		if _, _, err := builder.Build(); err == nil {
+			t.Errorf("expect error since blank WHERE is same as UPDATE without WHERE")
+		}
+
+		sql, _, err := builder.AllRows(true).Build()
+		if err != nil {
+			t.Error(err)
+		} else if strings.Compare("UPDATE member\nSET name = ?", sql) != 0 {
+			t.Errorf("Expect UPDATE without WHERE but get:\n%s", sql)
+		}
+	}
+}
+
+func TestUpdateBuilder_Validate(t *testing.T) {
+	err := NewUpdateBuilder().
+		Table("invoice", "a").
+		Join("customer", "b", InnerJoin, "").
+		WhereComplex(NewCondition("").AddAndArgs("b.score > ? AND b.rank < ?", 100)).
+		Validate()
+
+	expectValidationProblems(t, err, []string{
+		"set: must atleast have one SET column",
+		"join[0].on: condition expression cannot be empty",
+		"where: condition expression cannot be empty",
+		"where.condition[0]: expression (b.score > ? AND b.rank < ?) has more placeholders than 1 argument(s)"})
+}
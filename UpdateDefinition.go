@@ -0,0 +1,229 @@
+package rdbmstool
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+//SetDefinition SQL UPDATE SET column assignment definition
+type SetDefinition struct {
+	Column string
+	Value  interface{} //bound as argument; use Expression type for raw SQL expression
+}
+
+//UpdateDefinition SQL UPDATE statement definition
+type UpdateDefinition struct {
+	Table string
+	Alias string
+	Set   []SetDefinition
+	Join  []JoinDefinition
+	Where *ConditionDefinition
+
+	//AllowAllRows explicit opt-in to generate UPDATE statement without WHERE condition
+	AllowAllRows bool
+}
+
+//SQL generate UPDATE SQL string with default dialect
+func (update *UpdateDefinition) SQL() (string, error) {
+	return update.SQLDialect(DefaultDialect())
+}
+
+//SQLDialect generate UPDATE SQL string with specified dialect
+func (update *UpdateDefinition) SQLDialect(dialect Dialect) (string, error) {
+	return unboundSQL(update.Build(dialect))
+}
+
+//Build generate UPDATE SQL string and its bound arguments with specified dialect
+func (update *UpdateDefinition) Build(dialect Dialect) (string, []interface{}, error) {
+	if err := update.Validate(); err != nil {
+		return "", nil, err
+	}
+
+	binder := newArgBinder(dialect)
+
+	var sql string
+	var err error
+
+	if len(update.Join) == 0 {
+		sql, err = update.buildSimple(binder)
+	} else {
+		switch binder.dialect.UpdateJoin() {
+		case UpdateJoinInline:
+			sql, err = update.buildJoinInline(binder)
+		case UpdateJoinFrom:
+			sql, err = update.buildJoinFrom(binder)
+		case UpdateJoinFromTarget:
+			sql, err = update.buildJoinFromTarget(binder)
+		default:
+			err = fmt.Errorf("dialect %s not support UPDATE with JOIN", binder.dialect.Name())
+		}
+	}
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	return sql, binder.args, nil
+}
+
+//Validate check UPDATE definition integrity
+func (update *UpdateDefinition) Validate() error {
+	v := &validator{}
+
+	if strings.Compare(update.Table, "") == 0 {
+		v.add("table", "table name cannot be empty")
+	}
+
+	if len(update.Set) == 0 {
+		v.add("set", "must atleast have one SET column")
+	}
+
+	for index, set := range update.Set {
+		if strings.Compare(set.Column, "") == 0 {
+			v.add(fmt.Sprintf("set[%d]", index), "SET column name cannot be empty")
+		}
+	}
+
+	for index := range update.Join {
+		v.merge(fmt.Sprintf("join[%d]", index), update.Join[index].Validate())
+	}
+
+	if isBlankCondition(update.Where) {
+		if !update.AllowAllRows {
+			v.add("where", "UPDATE without WHERE condition is refused; "+
+				"explicitly allow all rows if it is intended")
+		}
+	} else {
+		v.merge("where", update.Where.Validate())
+	}
+
+	return v.result()
+}
+
+func (update *UpdateDefinition) targetSQL() string {
+	if strings.Compare(update.Alias, "") == 0 {
+		return update.Table
+	}
+
+	return update.Table + " AS " + update.Alias
+}
+
+//UPDATE t SET ... WHERE ...
+func (update *UpdateDefinition) buildSimple(binder *argBinder) (string, error) {
+	if binder.dialect.UpdateJoin() == UpdateJoinFromTarget &&
+		strings.Compare(update.Alias, "") != 0 {
+		//T-SQL not allow alias on UPDATE target, use FROM clause instead
+		return update.buildJoinFromTarget(binder)
+	}
+
+	result := "UPDATE " + update.targetSQL()
+
+	result = result + "\n" + update.buildSet(binder)
+
+	whereSQL, whereErr := buildWhereClause(binder, update.Where, "")
+	if whereErr != nil {
+		return "", whereErr
+	}
+
+	return result + whereSQL, nil
+}
+
+//UPDATE t JOIN x ON ... SET ... WHERE ...
+func (update *UpdateDefinition) buildJoinInline(binder *argBinder) (string, error) {
+	result := "UPDATE " + update.targetSQL()
+
+	joinSQL, joinErr := buildJoinList(binder, update.Join)
+	if joinErr != nil {
+		return "", joinErr
+	}
+	result = result + joinSQL
+
+	result = result + "\n" + update.buildSet(binder)
+
+	whereSQL, whereErr := buildWhereClause(binder, update.Where, "")
+	if whereErr != nil {
+		return "", whereErr
+	}
+
+	return result + whereSQL, nil
+}
+
+//UPDATE t SET ... FROM x JOIN y ON ... WHERE <x join condition> AND ...
+func (update *UpdateDefinition) buildJoinFrom(binder *argBinder) (string, error) {
+	first := update.Join[0]
+	if first.Type != Join && first.Type != InnerJoin {
+		return "", fmt.Errorf(
+			"dialect %s require first UPDATE join to be inner join", binder.dialect.Name())
+	}
+
+	if first.Where == nil {
+		return "", errors.New("UPDATE first join must have join condition")
+	}
+
+	result := "UPDATE " + update.targetSQL()
+
+	result = result + "\n" + update.buildSet(binder)
+
+	sourceSQL, sourceErr := first.sourceSQL(binder)
+	if sourceErr != nil {
+		return "", fmt.Errorf("Failed to generate UPDATE FROM SQL string: %s", sourceErr.Error())
+	}
+	result = result + "\nFROM " + sourceSQL
+
+	joinSQL, joinErr := buildJoinList(binder, update.Join[1:])
+	if joinErr != nil {
+		return "", joinErr
+	}
+	result = result + joinSQL
+
+	onSQL, onErr := first.conditionSQL(binder)
+	if onErr != nil {
+		return "", fmt.Errorf("Failed to generate UPDATE FROM condition SQL string: %s", onErr.Error())
+	}
+
+	whereSQL, whereErr := buildWhereClause(binder, update.Where, onSQL)
+	if whereErr != nil {
+		return "", whereErr
+	}
+
+	return result + whereSQL, nil
+}
+
+//UPDATE alias SET ... FROM t AS alias JOIN x ON ... WHERE ...
+func (update *UpdateDefinition) buildJoinFromTarget(binder *argBinder) (string, error) {
+	target := update.Table
+	if strings.Compare(update.Alias, "") != 0 {
+		target = update.Alias
+	}
+
+	result := "UPDATE " + target
+
+	result = result + "\n" + update.buildSet(binder) + "\nFROM " + update.targetSQL()
+
+	joinSQL, joinErr := buildJoinList(binder, update.Join)
+	if joinErr != nil {
+		return "", joinErr
+	}
+	result = result + joinSQL
+
+	whereSQL, whereErr := buildWhereClause(binder, update.Where, "")
+	if whereErr != nil {
+		return "", whereErr
+	}
+
+	return result + whereSQL, nil
+}
+
+func (update *UpdateDefinition) buildSet(binder *argBinder) string {
+	result := ""
+	for index, set := range update.Set {
+		if index == 0 {
+			result = "SET " + set.Column + " = " + binder.bindValue(set.Value)
+		} else {
+			result = result + ", " + set.Column + " = " + binder.bindValue(set.Value)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,95 @@
+package rdbmstool
+
+//UpsertBuilder SQL UPSERT (insert or update on key conflict) statement builder
+type UpsertBuilder struct {
+	upsertDefinition *UpsertDefinition
+	dialect          Dialect
+}
+
+//NewUpsertBuilder create new UPSERT SQL string builder
+func NewUpsertBuilder() *UpsertBuilder {
+	return &UpsertBuilder{
+		upsertDefinition: &UpsertDefinition{
+			Table:           "",
+			Columns:         nil,
+			Values:          nil,
+			ConflictColumns: nil,
+			UpdateColumns:   nil,
+			DoNothing:       false,
+			Schema:          nil,
+		},
+		dialect: DefaultDialect()}
+}
+
+//Dialect set SQL dialect used to generate SQL string
+func (builder *UpsertBuilder) Dialect(dialect Dialect) *UpsertBuilder {
+	builder.dialect = dialect
+	return builder
+}
+
+//Into set target table name
+func (builder *UpsertBuilder) Into(tableName string) *UpsertBuilder {
+	builder.upsertDefinition.Table = tableName
+	return builder
+}
+
+//Columns set column names
+func (builder *UpsertBuilder) Columns(columnNames ...string) *UpsertBuilder {
+	builder.upsertDefinition.Columns = columnNames
+	return builder
+}
+
+//Values append a value row; use Expression type for raw SQL expression value
+func (builder *UpsertBuilder) Values(values ...interface{}) *UpsertBuilder {
+	builder.upsertDefinition.Values = append(builder.upsertDefinition.Values, values)
+	return builder
+}
+
+//ValuesClear clear all value rows
+func (builder *UpsertBuilder) ValuesClear() *UpsertBuilder {
+	builder.upsertDefinition.Values = nil
+	return builder
+}
+
+//OnConflict set conflict target column names; if not set, conflict target
+//is inferred from primary key or unique key of table definition (see Schema)
+func (builder *UpsertBuilder) OnConflict(columnNames ...string) *UpsertBuilder {
+	builder.upsertDefinition.ConflictColumns = columnNames
+	return builder
+}
+
+//Update set column names to update on conflict; default all non conflict columns
+func (builder *UpsertBuilder) Update(columnNames ...string) *UpsertBuilder {
+	builder.upsertDefinition.UpdateColumns = columnNames
+	builder.upsertDefinition.DoNothing = false
+	return builder
+}
+
+//DoNothing keep existing row untouched on conflict
+func (builder *UpsertBuilder) DoNothing() *UpsertBuilder {
+	builder.upsertDefinition.UpdateColumns = nil
+	builder.upsertDefinition.DoNothing = true
+	return builder
+}
+
+//Schema set table definition to verify column names and infer conflict target
+func (builder *UpsertBuilder) Schema(tableDef *TableDefinition) *UpsertBuilder {
+	builder.upsertDefinition.Schema = tableDef
+	return builder
+}
+
+//Validate check UPSERT definition integrity
+func (builder *UpsertBuilder) Validate() error {
+	return builder.upsertDefinition.Validate()
+}
+
+//SQL generate SQL string
+func (builder *UpsertBuilder) SQL() (string, error) {
+	return builder.upsertDefinition.SQLDialect(builder.dialect)
+}
+
+//Build generate SQL string with dialect placeholders and its bound arguments;
+//output is ready to pass into DbHandlerProxy.Exec(sql, args...)
+func (builder *UpsertBuilder) Build() (string, []interface{}, error) {
+	return builder.upsertDefinition.Build(builder.dialect)
+}
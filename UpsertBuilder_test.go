@@ -0,0 +1,147 @@
+package rdbmstool
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/guinso/rdbmstool/parser"
+)
+
+func TestUpsertBuilder_SQL(t *testing.T) {
+	tableDef := NewTableBuilder().
+		TableName("product").
+		AddColumnInt("id", 11, false).
+		AddColumnVarchar("sku", 20, false).
+		AddColumnVarchar("name", 100, false).
+		AddColumn("price", DOUBLE, 0, true, 0).
+		AddPrimaryKey("id").
+		AddUniqueKey("sku").
+		GetTableDefinition()
+
+	builder := NewUpsertBuilder().
+		Into("product").
+		Columns("sku", "name", "price").
+		Values("A001", "pencil", 1.5).
+		Values("A002", "eraser", 0.8).
+		Schema(tableDef)
+
+	testCases := []struct {
+		dialect     Dialect
+		expectedSQL string
+	}{
+		{NewMySQLDialect(),
+			"INSERT INTO product (sku, name, price)\n" +
+				"VALUES (?, ?, ?), (?, ?, ?)\n" +
+				"ON DUPLICATE KEY UPDATE name = VALUES(name), price = VALUES(price)"},
+		{NewPostgreSQLDialect(),
+			"INSERT INTO product (sku, name, price)\n" +
+				"VALUES ($1, $2, $3), ($4, $5, $6)\n" +
+				"ON CONFLICT (sku) DO UPDATE SET name = EXCLUDED.name, price = EXCLUDED.price"},
+		{NewSQLServerDialect(),
+			"MERGE INTO product AS target\n" +
+				"USING (VALUES (@p1, @p2, @p3), (@p4, @p5, @p6)) AS source (sku, name, price)\n" +
+				"ON target.sku = source.sku\n" +
+				"WHEN MATCHED THEN UPDATE SET name = source.name, price = source.price\n" +
+				"WHEN NOT MATCHED THEN INSERT (sku, name, price) VALUES (source.sku, source.name, source.price);"},
+	}
+
+	for _, testCase := range testCases {
+		sql, args, err := builder.Dialect(testCase.dialect).Build()
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+
+		if strings.Compare(testCase.expectedSQL, sql) != 0 {
+			t.Errorf("%s dialect expect:\n%s\n\nbut get:\n\n%s",
+				testCase.dialect.Name(), testCase.expectedSQL, sql)
+		}
+
+		if len(args) != 6 {
+			t.Errorf("%s dialect expect 6 bound arguments but get %d", testCase.dialect.Name(), len(args))
+		}
+	}
+
+	sql, _, err := builder.Dialect(NewSQLiteDialect()).DoNothing().Build()
+	if err != nil {
+		t.Error(err)
+	}
+
+	expectedSQL := "INSERT INTO product (sku, name, price)\n" +
+		"VALUES (?, ?, ?), (?, ?, ?)\n" +
+		"ON CONFLICT (sku) DO NOTHING"
+	if strings.Compare(expectedSQL, sql) != 0 {
+		t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expectedSQL, sql)
+	}
+}
+
+func TestUpsertBuilder_ConflictTarget(t *testing.T) {
+	builder := NewUpsertBuilder().
+		Dialect(NewPostgreSQLDialect()).
+		Into("product").
+		Columns("name", "price").
+		Values("pencil", 1.5)
+
+	if _, _, err := builder.Build(); err == nil {
+		t.Errorf("expect error since conflict target is not provided")
+	}
+
+	sql, _, err := builder.OnConflict("name").Update("price").Build()
+	if err != nil {
+		t.Error(err)
+	}
+
+	expectedSQL := "INSERT INTO product (name, price)\n" +
+		"VALUES ($1, $2)\n" +
+		"ON CONFLICT (name) DO UPDATE SET price = EXCLUDED.price"
+	if strings.Compare(expectedSQL, sql) != 0 {
+		t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expectedSQL, sql)
+	}
+
+	if _, _, err := builder.OnConflict("id").Build(); err == nil {
+		t.Errorf("expect error since conflict column is not an inserted column")
+	}
+}
+
+func TestUpsertBuilder_parse(t *testing.T) {
+	builder := NewUpsertBuilder().
+		Into("product").
+		Columns("sku", "name", "price").
+		Values("A001", "pencil", 1.5).
+		Values("A002", "eraser", 0.8).
+		OnConflict("sku")
+
+	for _, dialect := range []Dialect{NewMySQLDialect(), NewPostgreSQLDialect(), NewSQLiteDialect(), NewSQLServerDialect()} {
+		for _, doNothing := range []bool{false, true} {
+			upsert := builder.Dialect(dialect).Update()
+			if doNothing {
+				upsert = upsert.DoNothing()
+			}
+
+			sql, _, err := upsert.Build()
+			if err != nil {
+				t.Error(err)
+				continue
+			}
+
+			if _, err := parser.ParseSQL(sql); err != nil {
+				t.Errorf("%s dialect fail to parse:\n%s\n\n%s", dialect.Name(), sql, err.Error())
+			}
+		}
+	}
+}
+
+func TestUpsertBuilder_Validate(t *testing.T) {
+	err := NewUpsertBuilder().
+		Into("product").
+		Columns("sku", "name").
+		Values("A001").
+		OnConflict("id").
+		Update("price").
+		Validate()
+
+	expectValidationProblems(t, err, []string{
+		"values[0]: has 1 value(s) but 2 column(s) defined",
+		"conflict column[0](id): conflict column is not an inserted column",
+		"update column[0](price): update column is not an inserted column"})
+}
@@ -0,0 +1,241 @@
+package rdbmstool
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+//UpsertDefinition SQL statement definition to insert new row(s) or
+//update existing row(s) when key conflict occur
+type UpsertDefinition struct {
+	Table   string
+	Columns []string
+	Values  [][]interface{} //one or more value rows
+
+	ConflictColumns []string //conflict target; inferred from Schema if empty
+	UpdateColumns   []string //columns to update on conflict; default all non conflict columns
+	DoNothing       bool     //keep existing row untouched on conflict
+
+	Schema *TableDefinition //optional, used to verify column names and infer conflict target
+}
+
+//SQL generate UPSERT SQL string with default dialect
+func (upsert *UpsertDefinition) SQL() (string, error) {
+	return upsert.SQLDialect(DefaultDialect())
+}
+
+//SQLDialect generate UPSERT SQL string with specified dialect
+func (upsert *UpsertDefinition) SQLDialect(dialect Dialect) (string, error) {
+	return unboundSQL(upsert.Build(dialect))
+}
+
+//Build generate UPSERT SQL string and its bound arguments with specified dialect
+func (upsert *UpsertDefinition) Build(dialect Dialect) (string, []interface{}, error) {
+	if err := upsert.Validate(); err != nil {
+		return "", nil, err
+	}
+
+	dialect = resolveDialect(dialect)
+	binder := newArgBinder(dialect)
+
+	conflictCols, conflictErr := upsert.ConflictTarget()
+	if conflictErr != nil && dialect.Upsert() != UpsertOnDuplicateKey {
+		return "", nil, conflictErr
+	}
+
+	updateCols := upsert.updateColumns(conflictCols)
+
+	switch dialect.Upsert() {
+	case UpsertOnDuplicateKey:
+		return upsert.buildOnDuplicateKey(binder, updateCols)
+	case UpsertOnConflict:
+		return upsert.buildOnConflict(binder, conflictCols, updateCols)
+	case UpsertMerge:
+		return upsert.buildMerge(binder, conflictCols, updateCols)
+	default:
+		return "", nil, fmt.Errorf("%s dialect not support UPSERT statement", dialect.Name())
+	}
+}
+
+func (upsert *UpsertDefinition) insertSQL(binder *argBinder) string {
+	return "INSERT INTO " + upsert.Table +
+		" (" + strings.Join(upsert.Columns, ", ") + ")" +
+		"\nVALUES " + buildValueRows(binder, upsert.Values)
+}
+
+func (upsert *UpsertDefinition) buildOnDuplicateKey(binder *argBinder,
+	updateCols []string) (string, []interface{}, error) {
+
+	result := upsert.insertSQL(binder) + "\nON DUPLICATE KEY UPDATE "
+
+	if len(updateCols) == 0 {
+		//no-op assignment to keep existing row untouched
+		return result + upsert.Columns[0] + " = " + upsert.Columns[0], binder.args, nil
+	}
+
+	for index, col := range updateCols {
+		if index > 0 {
+			result = result + ", "
+		}
+		result = result + col + " = VALUES(" + col + ")"
+	}
+
+	return result, binder.args, nil
+}
+
+func (upsert *UpsertDefinition) buildOnConflict(binder *argBinder,
+	conflictCols []string, updateCols []string) (string, []interface{}, error) {
+
+	result := upsert.insertSQL(binder) +
+		"\nON CONFLICT (" + strings.Join(conflictCols, ", ") + ")"
+
+	if len(updateCols) == 0 {
+		return result + " DO NOTHING", binder.args, nil
+	}
+
+	result = result + " DO UPDATE SET "
+	for index, col := range updateCols {
+		if index > 0 {
+			result = result + ", "
+		}
+		result = result + col + " = EXCLUDED." + col
+	}
+
+	return result, binder.args, nil
+}
+
+func (upsert *UpsertDefinition) buildMerge(binder *argBinder,
+	conflictCols []string, updateCols []string) (string, []interface{}, error) {
+
+	result := "MERGE INTO " + upsert.Table + " AS target" +
+		"\nUSING (VALUES " + buildValueRows(binder, upsert.Values) + ") AS source" +
+		" (" + strings.Join(upsert.Columns, ", ") + ")"
+
+	for index, col := range conflictCols {
+		if index == 0 {
+			result = result + "\nON target." + col + " = source." + col
+		} else {
+			result = result + " AND target." + col + " = source." + col
+		}
+	}
+
+	for index, col := range updateCols {
+		if index == 0 {
+			result = result + "\nWHEN MATCHED THEN UPDATE SET "
+		} else {
+			result = result + ", "
+		}
+		result = result + col + " = source." + col
+	}
+
+	sourceCols := make([]string, len(upsert.Columns))
+	for index, col := range upsert.Columns {
+		sourceCols[index] = "source." + col
+	}
+
+	result = result + "\nWHEN NOT MATCHED THEN INSERT (" + strings.Join(upsert.Columns, ", ") + ")" +
+		" VALUES (" + strings.Join(sourceCols, ", ") + ");"
+
+	return result, binder.args, nil
+}
+
+//ConflictTarget get conflict target columns; if ConflictColumns is empty,
+//infer from Schema primary key or unique key which columns are all inserted
+func (upsert *UpsertDefinition) ConflictTarget() ([]string, error) {
+	if len(upsert.ConflictColumns) > 0 {
+		return upsert.ConflictColumns, nil
+	}
+
+	if upsert.Schema == nil {
+		return nil, errors.New("UPSERT conflict columns cannot be empty if table definition is not provided")
+	}
+
+	if len(upsert.Schema.PrimaryKey) > 0 && upsert.hasColumns(upsert.Schema.PrimaryKey) {
+		return upsert.Schema.PrimaryKey, nil
+	}
+
+	for _, uk := range upsert.Schema.UniqueKeys {
+		if len(uk.ColumnNames) > 0 && upsert.hasColumns(uk.ColumnNames) {
+			return uk.ColumnNames, nil
+		}
+	}
+
+	return nil, fmt.Errorf(
+		"UPSERT conflict target cannot be inferred: no primary key or unique key of table (%s) "+
+			"is fully covered by inserted columns", upsert.Schema.Name)
+}
+
+//updateColumns get columns to update on conflict
+func (upsert *UpsertDefinition) updateColumns(conflictCols []string) []string {
+	if upsert.DoNothing {
+		return nil
+	}
+
+	if len(upsert.UpdateColumns) > 0 {
+		return upsert.UpdateColumns
+	}
+
+	result := []string{}
+	for _, col := range upsert.Columns {
+		if !containsString(conflictCols, col) {
+			result = append(result, col)
+		}
+	}
+
+	return result
+}
+
+func (upsert *UpsertDefinition) hasColumns(columnNames []string) bool {
+	for _, colName := range columnNames {
+		if !containsString(upsert.Columns, colName) {
+			return false
+		}
+	}
+
+	return true
+}
+
+//Validate check UPSERT definition integrity
+func (upsert *UpsertDefinition) Validate() error {
+	v := &validator{}
+
+	insert := &InsertDefinition{
+		Table:   upsert.Table,
+		Columns: upsert.Columns,
+		Values:  upsert.Values,
+		Query:   nil,
+		Schema:  upsert.Schema}
+	v.merge("", insert.Validate())
+
+	for index, colName := range upsert.ConflictColumns {
+		if !containsString(upsert.Columns, colName) {
+			v.add(fmt.Sprintf("conflict column[%d](%s)", index, colName),
+				"conflict column is not an inserted column")
+		}
+	}
+
+	if upsert.DoNothing && len(upsert.UpdateColumns) > 0 {
+		v.add("update columns", "cannot have both update columns and do nothing option")
+	}
+
+	for index, colName := range upsert.UpdateColumns {
+		if !containsString(upsert.Columns, colName) {
+			v.add(fmt.Sprintf("update column[%d](%s)", index, colName),
+				"update column is not an inserted column")
+		}
+	}
+
+	return v.result()
+}
+
+//containsString check value is found in string slice
+func containsString(values []string, value string) bool {
+	for _, item := range values {
+		if strings.Compare(item, value) == 0 {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,81 @@
+package rdbmstool
+
+import (
+	"fmt"
+	"strings"
+)
+
+//ValidationProblem a single definition integrity problem
+type ValidationProblem struct {
+	Field   string //path to invalid definition, example: column[2](name)
+	Message string
+}
+
+//String return problem in "field: message" format
+func (problem ValidationProblem) String() string {
+	if strings.Compare(problem.Field, "") == 0 {
+		return problem.Message
+	}
+
+	return problem.Field + ": " + problem.Message
+}
+
+//ValidationError all integrity problems found while validating a definition
+type ValidationError struct {
+	Problems []ValidationProblem
+}
+
+//Error list every problem in separate line
+func (err *ValidationError) Error() string {
+	result := fmt.Sprintf("%d validation problem(s) found", len(err.Problems))
+	for _, problem := range err.Problems {
+		result = result + "\n- " + problem.String()
+	}
+
+	return result
+}
+
+//validator collect validation problems
+type validator struct {
+	problems []ValidationProblem
+}
+
+//add register a problem on specified field
+func (v *validator) add(field string, format string, args ...interface{}) {
+	v.problems = append(v.problems, ValidationProblem{
+		Field:   field,
+		Message: fmt.Sprintf(format, args...)})
+}
+
+//merge register problems returned from nested definition; every problem field is
+//prefixed with specified field
+func (v *validator) merge(field string, err error) {
+	if err == nil {
+		return
+	}
+
+	validationErr, ok := err.(*ValidationError)
+	if !ok {
+		v.add(field, "%s", err.Error())
+		return
+	}
+
+	for _, problem := range validationErr.Problems {
+		if strings.Compare(problem.Field, "") == 0 {
+			v.add(field, "%s", problem.Message)
+		} else if strings.Compare(field, "") == 0 {
+			v.add(problem.Field, "%s", problem.Message)
+		} else {
+			v.add(field+"."+problem.Field, "%s", problem.Message)
+		}
+	}
+}
+
+//result return ValidationError if any problem found; otherwise nil
+func (v *validator) result() error {
+	if len(v.problems) == 0 {
+		return nil
+	}
+
+	return &ValidationError{Problems: v.problems}
+}
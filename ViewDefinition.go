@@ -1,6 +1,9 @@
 package rdbmstool
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 //ViewDefinition Data Views definition
 type ViewDefinition struct {
@@ -17,13 +20,35 @@ func NewViewDefinition(viewName string) *ViewDefinition {
 	}
 }
 
-//SQL generate View SQL string
+//SQL generate View SQL string with query builder's dialect
 func (viewDef *ViewDefinition) SQL() (string, error) {
+	return viewDef.SQLDialect(viewDef.Query.dialect)
+}
+
+//SQLDialect generate View SQL string with specified dialect
+func (viewDef *ViewDefinition) SQLDialect(dialect Dialect) (string, error) {
 
-	query, err := viewDef.Query.SQL()
+	query, err := viewDef.Query.selectDefinition.SQLDialect(dialect)
 	if err != nil {
 		return "", err
 	}
 
-	return fmt.Sprintf("CREATE VIEW %s AS \n%s", viewDef.Name, query), nil
+	return fmt.Sprintf("CREATE VIEW %s AS \n%s", dialect.QuoteIdentifier(viewDef.Name), query), nil
+}
+
+//Validate check view definition integrity
+func (viewDef *ViewDefinition) Validate() error {
+	v := &validator{}
+
+	if strings.Compare(viewDef.Name, "") == 0 {
+		v.add("name", "view name cannot be empty")
+	}
+
+	if viewDef.Query == nil || viewDef.Query.selectDefinition == nil {
+		v.add("query", "view query cannot be empty")
+	} else {
+		v.merge("query", viewDef.Query.selectDefinition.Validate())
+	}
+
+	return v.result()
 }
@@ -31,7 +31,7 @@ func TestViewDefinition_SQL(t *testing.T) {
 		t.Error(err.Error())
 	}
 
-	expectedSQL := `CREATE VIEW student AS 
+	expectedSQL := "CREATE VIEW `student` AS " + `
 SELECT a.name, a.years_old AS age
 FROM student AS a
 INNER JOIN school AS b ON a.school = b.name
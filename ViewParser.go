@@ -0,0 +1,74 @@
+package rdbmstool
+
+import (
+	"fmt"
+
+	"github.com/guinso/rdbmstool/parser"
+)
+
+//ParseViewDefinition parse CREATE VIEW statement into view definition with default dialect
+func ParseViewDefinition(sql string) (*ViewDefinition, error) {
+	return ParseViewDefinitionDialect(sql, DefaultDialect())
+}
+
+//ParseViewDefinitionDialect parse CREATE VIEW statement written in specified dialect into
+//view definition
+func ParseViewDefinitionDialect(sql string, dialect Dialect) (*ViewDefinition, error) {
+	ast, err := parseSQL(sql, dialect)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewViewDefinitionFromSyntaxTree(ast)
+}
+
+//NewViewDefinitionFromSyntaxTree convert parsed CREATE VIEW statement (NodeCreateView) into
+//view definition; view column names become alias of selected columns
+func NewViewDefinitionFromSyntaxTree(ast *parser.SyntaxTree) (*ViewDefinition, error) {
+	if ast.DataType != parser.NodeCreateView {
+		return nil, fmt.Errorf("syntax tree node type (%d) is not a CREATE VIEW statement", ast.DataType)
+	}
+
+	viewDef := NewViewDefinition("")
+	columnNames := []string{}
+	var query *SelectDefinition
+
+	for index := range ast.ChildNodes {
+		node := &ast.ChildNodes[index]
+
+		switch node.DataType {
+		case parser.NodeSource:
+			//schema name is not part of view definition
+			viewDef.Name = parser.UnquoteIdentifier(node.Source[node.EndPosition].Value)
+		case parser.NodeList:
+			columnNames = listColumnNames(node)
+		case parser.NodeCreateOption:
+			//OR REPLACE, TEMPORARY and IF NOT EXISTS are decided when view is created
+		default:
+			tmp, err := NewSelectDefinitionFromSyntaxTree(node)
+			if err != nil {
+				return nil, fmt.Errorf("view (%s) %s", viewDef.Name, err.Error())
+			}
+			query = tmp
+		}
+	}
+
+	if query == nil {
+		return nil, fmt.Errorf("view (%s) has no SELECT statement", viewDef.Name)
+	}
+
+	if len(columnNames) > 0 {
+		if len(columnNames) != len(query.Select) {
+			return nil, fmt.Errorf("view (%s) has %d column name(s) but query select %d column(s)",
+				viewDef.Name, len(columnNames), len(query.Select))
+		}
+
+		for index := range query.Select {
+			query.Select[index].Alias = columnNames[index]
+		}
+	}
+
+	viewDef.Query.selectDefinition = query
+
+	return viewDef, nil
+}
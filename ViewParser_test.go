@@ -0,0 +1,69 @@
+package rdbmstool
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseViewDefinition(t *testing.T) {
+	sql := "CREATE VIEW `student` AS \n" +
+		"SELECT a.name, a.years_old AS age\n" +
+		"FROM student AS a\n" +
+		"INNER JOIN school AS b ON a.school = b.name\n" +
+		"WHERE a.l = 4 OR a.age > 4 AND (b.name = 'john' AND b.k <> 8)\n" +
+		"GROUP BY b.name\n" +
+		"HAVING a.name = 'john'\n" +
+		"ORDER BY a.name, age\n" +
+		"LIMIT 20 OFFSET 5"
+
+	viewDef, err := ParseViewDefinition(sql)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := viewDef.SQL()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Compare(result, sql) != 0 {
+		t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", sql, result)
+	}
+
+	viewDef, err = ParseViewDefinition("CREATE OR REPLACE VIEW `shop`.`customer_total` (`id`, total) AS " +
+		"SELECT a.id, SUM(b.amount) FROM customer a JOIN invoice b ON b.customer_id = a.id GROUP BY a.id;")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err = viewDef.Query.WhereAddAnd("a.id > 10").SQL()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "SELECT a.id AS id, SUM(b.amount) AS total\n" +
+		"FROM customer AS a\n" +
+		"JOIN invoice AS b ON b.customer_id = a.id\n" +
+		"WHERE a.id > 10\n" +
+		"GROUP BY a.id"
+	if strings.Compare(viewDef.Name, "customer_total") != 0 {
+		t.Errorf("Expect view name customer_total but get %s", viewDef.Name)
+	}
+
+	if strings.Compare(result, expected) != 0 {
+		t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expected, result)
+	}
+
+	invalidSQLs := []string{
+		"CREATE VIEW a (x) AS SELECT b, c FROM d",
+		"CREATE VIEW a () AS SELECT b FROM d",
+		"CREATE VIEW a SELECT b FROM d",
+		"CREATE VIEW a AS",
+		"SELECT b FROM d"}
+
+	for _, sql := range invalidSQLs {
+		if _, err := ParseViewDefinition(sql); err == nil {
+			t.Errorf("Expect error for: %s", sql)
+		}
+	}
+}
@@ -7,13 +7,15 @@ import (
 	"unicode/utf8"
 )
 
-//lex create a new scanner for the input string
-func lex(name, input string) *lexer {
+//lex create a new scanner for the input string; double quoted text is scanned as identifier
+//if ansiQuotes is true, otherwise it is scanned as string
+func lex(name, input string, ansiQuotes bool) *lexer {
 	l := &lexer{
-		name:  name,
-		input: input,
-		items: make(chan tokenItem),
-		line:  1}
+		name:       name,
+		input:      input,
+		items:      make(chan tokenItem),
+		line:       1,
+		ansiQuotes: ansiQuotes}
 
 	go l.run()
 	return l
@@ -28,6 +30,8 @@ type lexer struct {
 	line  int            //line number of input
 	width int            //length of last rune read from in
 	items chan tokenItem //channel of scanned items
+
+	ansiQuotes bool //double quoted text is identifier (ANSI SQL) instead of string (MySQL)
 }
 
 // error returns an error token and terminates the scan
@@ -46,25 +50,39 @@ func (l *lexer) errorf(format string, args ...interface{}) StateFn {
 //Tokenize convert string context into array of tokens
 //token array is use for next process: transform into abstract syntax tree
 func tokenize(inputStr string) []tokenItem {
+	result, _ := tokenizeSQL(inputStr, false)
+
+	return result
+}
+
+//tokenizeSQL convert string context into array of tokens; lexical error is
+//returned as *ParseError while tokens scanned before error are kept
+func tokenizeSQL(inputStr string, ansiQuotes bool) ([]tokenItem, error) {
 	result := []tokenItem{}
 
-	lexer := lex("tokenize", inputStr)
+	lexer := lex("tokenize", inputStr, ansiQuotes)
 
 	for true {
 		tmpToken := lexer.nextItem()
 
 		if tmpToken.Type == TokenError {
-			break
+			lexer.drain()
+
+			//lexical error is pointed to first character which is not recognized
+			parseErr := newParseError(tmpToken, "%s", tmpToken.Value)
+			_, width := utf8.DecodeRuneInString(inputStr[tmpToken.Pos:])
+			parseErr.Token = inputStr[tmpToken.Pos : tmpToken.Pos+width]
+			return result, parseErr
 		}
 
 		result = append(result, tmpToken)
 
-		if tmpToken.Type == eof {
+		if tmpToken.Type == TokenEOF {
 			break
 		}
 	}
 
-	return result
+	return result, nil
 }
 
 // nextItem returns the next item from the input.
@@ -13,7 +13,8 @@ func TestLexer_peekAhead(t *testing.T) {
 		0,
 		0,
 		0,
-		make(chan tokenItem)}
+		make(chan tokenItem),
+		false}
 
 	r := lexer.peekAhead(4)
 
@@ -33,7 +34,7 @@ func TestLexer_nextItem(t *testing.T) {
 		TokenAs,
 		TokenLiteral,
 		TokenColon,
-		TokenSum,
+		TokenLiteral,
 		TokenLeftParen,
 		TokenLiteral,
 		TokenRightParen,
@@ -43,7 +44,7 @@ func TestLexer_nextItem(t *testing.T) {
 		TokenLiteral,
 	}
 
-	lexer := lex("testing", "SELECT a.*, name AS koko, SUM(qty), 'qwe' FROM invoice")
+	lexer := lex("testing", "SELECT a.*, name AS koko, SUM(qty), 'qwe' FROM invoice", false)
 
 	var token tokenItem
 	for _, item := range items {
@@ -116,3 +117,51 @@ func TestLexer_tokenize(t *testing.T) {
 		}
 	}
 }
+
+func TestLexer_comparison(t *testing.T) {
+	expectedTokens := []TokenType{
+		TokenLiteral, TokenGreaterEqual, TokenNumber,
+		TokenLiteral, TokenLesserEqual, TokenNumber,
+		TokenLiteral, TokenNotEqual, TokenNumber,
+		TokenLiteral, TokenGreater, TokenNumber,
+		TokenLiteral, TokenLesser, TokenNumber,
+		TokenEOF,
+	}
+
+	tokens := tokenize("a >= 1 b<=2 c <> 3 d>4 e < 5")
+
+	if len(expectedTokens) != len(tokens) {
+		t.Fatalf("tokens quantity not tally, expect %d, actual get %d", len(expectedTokens), len(tokens))
+	}
+
+	for i := 0; i < len(expectedTokens); i++ {
+		if expectedTokens[i] != tokens[i].Type {
+			t.Errorf("Expect token %s at index %d, but get %s",
+				expectedTokens[i].String(), i, tokens[i].Type.String())
+		}
+	}
+}
+
+func TestLexer_doubleQuote(t *testing.T) {
+	expectedTokens := map[bool][]TokenType{
+		false: {TokenLiteral, TokenEqual, TokenString, TokenEOF},  //MySQL
+		true:  {TokenLiteral, TokenEqual, TokenLiteral, TokenEOF}} //ANSI SQL
+
+	for ansiQuotes, expected := range expectedTokens {
+		tokens, err := tokenizeSQL("a = \"x\"", ansiQuotes)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(expected) != len(tokens) {
+			t.Fatalf("tokens quantity not tally, expect %d, actual get %d", len(expected), len(tokens))
+		}
+
+		for i := 0; i < len(expected); i++ {
+			if expected[i] != tokens[i].Type {
+				t.Errorf("Expect token %s at index %d, but get %s (ANSI quotes: %t)",
+					expected[i].String(), i, tokens[i].Type.String(), ansiQuotes)
+			}
+		}
+	}
+}
@@ -26,6 +26,7 @@ var kw = []tokenItem{
 	{TokenOn, "on", 0, 0},
 	{TokenBetween, "between", 0, 0},
 	{TokenLike, "like", 0, 0},
+	{TokenIn, "in", 0, 0},
 	{TokenCreate, "create", 0, 0},
 	{TokenTable, "table", 0, 0},
 	{TokenView, "view", 0, 0},
@@ -35,10 +36,18 @@ var kw = []tokenItem{
 	{TokenAnd, "and", 0, 0},
 	{TokenOr, "or", 0, 0},
 	{TokenNot, "not", 0, 0},
+	{TokenInsert, "insert", 0, 0},
+	{TokenUpdate, "update", 0, 0},
+	{TokenDelete, "delete", 0, 0},
+	{TokenSet, "set", 0, 0},
+	{TokenValues, "values", 0, 0},
+	{TokenInto, "into", 0, 0},
+	{TokenExists, "exists", 0, 0},
 	//{tokenDistinct, "distinct", 0, 0},
 	{TokenGroupBy, "group by", 0, 0},
 }
 
+//symbols is matched in listed order; longer symbol must be listed before its prefix (example: >= before >)
 var symbols = []struct {
 	Type  TokenType
 	Value string
@@ -46,10 +55,10 @@ var symbols = []struct {
 	{TokenEqual, "="},
 	{TokenNotEqual, "<>"},
 	{TokenNotEqual, "!="},
-	{TokenGreater, ">"},
 	{TokenGreaterEqual, ">="},
-	{TokenLesser, "<"},
+	{TokenGreater, ">"},
 	{TokenLesserEqual, "<="},
+	{TokenLesser, "<"},
 	{TokenQuestionMark, "?"},
 	{TokenWildcard, "%"},
 	{TokenAsterisk, "*"},
@@ -63,16 +72,6 @@ var symbols = []struct {
 	{TokenSemiColon, ";"},
 }
 
-var fns = []tokenItem{
-	{TokenSum, "sum", 0, 0},
-	{TokenMin, "min", 0, 0},
-	{TokenMax, "max", 0, 0},
-	{TokenAvg, "avg", 0, 0},
-	{TokenCount, "count", 0, 0},
-	{TokenGreatest, "greatest", 0, 0},
-	//{tokenDistinct, "distinct", 0, 0},
-}
-
 func isWhiteSpace(input rune) bool {
 	return input == ' ' || input == '\n' || input == '\t'
 }
@@ -113,6 +112,14 @@ func isKeywordMatch(lex *lexer, keyword string) bool {
 		(isWhiteSpace(aheadRune) || isSymbol(aheadRune) || aheadRune == eof)
 }
 
+//isWordMatch check keyword is a whole word rather than prefix of literal (example: order_id)
+func isWordMatch(lex *lexer, keyword string) bool {
+	aheadRune := lex.peekAhead(len(keyword) + 1)
+
+	return lex.matchPrefix(strings.ToUpper(keyword), strings.ToLower(keyword)) &&
+		!isLiteralCharacter(aheadRune)
+}
+
 func lexText(lex *lexer) StateFn {
 	nr1 := lex.peekAhead(1)
 	nr2 := lex.peekAhead(2)
@@ -125,6 +132,11 @@ func lexText(lex *lexer) StateFn {
 		return lexText
 	}
 
+	//comment is rejected rather than lexed as operators since it cannot be kept by parser
+	if (nr1 == '-' && nr2 == '-') || (nr1 == '/' && nr2 == '*') {
+		return lex.errorf("SQL comment is not supported")
+	}
+
 	//looking for keyword if match
 	for _, k := range kw {
 		if isKeywordMatch(lex, k.Value) {
@@ -132,38 +144,30 @@ func lexText(lex *lexer) StateFn {
 		}
 	}
 
-	//looking for function if match
-	for _, fn := range fns {
-		if strings.HasPrefix(subString, strings.ToUpper(fn.Value)+"(") ||
-			strings.HasPrefix(subString, strings.ToLower(fn.Value)+"(") {
-			return lexFunction(lex, fn.Value, fn.Type)
-		}
-	}
-
 	//handle complex keyword(s)
-	if lex.matchPrefix("group", "GROUP") {
+	if isWordMatch(lex, "group") {
 		return lexGroupBy(lex)
-	} else if lex.matchPrefix("order", "ORDER") {
+	} else if isWordMatch(lex, "order") {
 		return lexOrderBy(lex)
-	} else if lex.matchPrefix("inner", "INNER") {
+	} else if isWordMatch(lex, "inner") {
 		return lexJoin(lex, TokenInnerJoin, 5)
-	} else if lex.matchPrefix("outer", "OUTER") {
+	} else if isWordMatch(lex, "outer") {
 		return lexJoin(lex, TokenOuterJoin, 5)
-	} else if lex.matchPrefix("left", "LEFT") {
+	} else if isWordMatch(lex, "left") {
 		return lexJoin(lex, TokenLeftJoin, 4)
-	} else if lex.matchPrefix("right", "RIGHT") {
+	} else if isWordMatch(lex, "right") {
 		return lexJoin(lex, TokenRightJoin, 5)
-	} else if lex.matchPrefix("join", "JOIN") {
+	} else if isWordMatch(lex, "join") {
 		if xErr := lex.fastForward(4); xErr != nil {
-			return lex.errorf("fail to tokenize JOIN token at %d", lex.pos)
+			return lex.errorf("fail to tokenize JOIN token")
 		}
 
 		lex.emit(TokenJoin)
 		return lexText
 	}
 
-	//looking for SQL parameter
-	if nr1 == ':' && isLetter(nr2) {
+	//looking for SQL parameter; example: :name, $1 (PostgreSQL), @p1 (SQL Server)
+	if (nr1 == ':' && isLetter(nr2)) || (nr1 == '$' && isNumeric(nr2)) || (nr1 == '@' && isLetter(nr2)) {
 		return lexParameter(lex)
 	}
 
@@ -175,10 +179,20 @@ func lexText(lex *lexer) StateFn {
 	//looking for quoted string
 	if nr1 == '\'' {
 		return lexQuoteString(lex)
-	} else if nr1 == '"' {
+	}
+
+	//double quoted text is string in MySQL while it is identifier in ANSI SQL
+	if nr1 == '"' && !lex.ansiQuotes {
 		return lexQuoteDoubleString(lex)
 	}
 
+	//looking for quoted identifier; ANSI double quote or SQL Server bracket
+	if nr1 == '"' {
+		return lexQuoteDoubleLiteral(lex)
+	} else if nr1 == '[' && isLetter(nr2) {
+		return lexBracketLiteral(lex)
+	}
+
 	//looking for literal (literal can start with backquote or without backquote)
 	if (nr1 == '`' && isLetter(nr2)) || isLetter(nr1) {
 		return lexLiteral(lex)
@@ -203,8 +217,8 @@ func lexText(lex *lexer) StateFn {
 	}
 
 	//handle unspecified token
-	return lex.errorf("Syntax error charactor(%s) not recognize by SQL lexical analysis; line %d, column %d",
-		strconv.QuoteRune(nr1), lex.line, lex.pos+1)
+	return lex.errorf("Syntax error charactor(%s) not recognize by SQL lexical analysis",
+		strconv.QuoteRune(nr1))
 }
 
 //lexKeyword
@@ -215,14 +229,6 @@ func lexKeyword(lex *lexer, keyword string, tokenTypee TokenType) StateFn {
 	return lexText
 }
 
-//lexFunction
-func lexFunction(lex *lexer, keyword string, tokenTypee TokenType) StateFn {
-	lex.fastForward(len(keyword))
-	lex.emit(tokenTypee)
-
-	return lexText
-}
-
 func lexJoin(lex *lexer, tokenTy TokenType, keywordLen int) StateFn {
 
 	//fast forward the keyword
@@ -242,12 +248,11 @@ func lexJoin(lex *lexer, tokenTy TokenType, keywordLen int) StateFn {
 			continue //keep looping
 		} else if r == eof {
 			//handle syntax error
-			return lex.errorf("unexpected end of file reached at column %d", lex.pos)
+			return lex.errorf("unexpected end of file reached")
 		}
 
 		if r := lex.peek(); !isWhiteSpace(r) {
-			return lex.errorf("syntax error detected for JOIN statement, "+
-				"expect white space after 'join' keyword at column %d", lex.pos)
+			return lex.errorf("syntax error detected for JOIN statement, expect white space after 'join' keyword")
 		}
 	}
 
@@ -280,11 +285,10 @@ func lexGroupBy(lex *lexer) StateFn {
 			continue
 		} else if r == eof {
 			//handle syntax error
-			return lex.errorf("unexpected end of file reached at column %d", lex.pos)
+			return lex.errorf("unexpected end of file reached")
 		}
 
-		return lex.errorf("syntax error detected for Group By statement, "+
-			"expect white space after 'by' keyword at column %d", lex.pos)
+		return lex.errorf("syntax error detected for Group By statement, expect white space after 'by' keyword")
 	}
 
 	//fast forward lexer to end of 'by' keyword
@@ -294,7 +298,7 @@ func lexGroupBy(lex *lexer) StateFn {
 
 	// if r := lex.peek(); !isWhiteSpace(r) {
 	// 	return lex.errorf("syntax error detected for Group By statement, "+
-	// 		"expect white space after 'by' keyword at column %d", lex.pos)
+	// 		"expect white space after 'by' keyword")
 	// }
 
 	lex.emit(tokenTy)
@@ -323,12 +327,11 @@ func lexOrderBy(lex *lexer) StateFn {
 			continue
 		} else if r == eof {
 			//handle syntax error
-			return lex.errorf("unexpected end of file reached at column %d", lex.pos)
+			return lex.errorf("unexpected end of file reached")
 		}
 
 		if r := lex.peek(); !isWhiteSpace(r) {
-			return lex.errorf("syntax error detected for Order By statement, "+
-				"expect white space after 'by' keyword at column %d", lex.pos)
+			return lex.errorf("syntax error detected for Order By statement, expect white space after 'by' keyword")
 		}
 	}
 
@@ -366,21 +369,15 @@ func lexNumber(l *lexer) StateFn {
 }
 
 func lexParameter(lex *lexer) StateFn {
-	lex.next() //accept semi colon
-	lex.next() //accept letter
+	lex.next() //accept parameter prefix
+	lex.next() //accept first letter or digit
 
-	for { //break when reach white space
+	for { //break when reach non literal character; example: white space, comma, or close bracket
 		r := lex.next()
 
-		if isWhiteSpace(r) || r == eof {
+		if !isLiteralCharacter(r) {
+			lex.backup() //exclude non literal character from parameter name
 			break
-		} else if isLiteralCharacter(r) {
-			continue
-		} else {
-			//handler syntax error
-			return lex.errorf(
-				"syntax error detected on lexing SQL parameter at line %d, column %d",
-				lex.line, lex.pos)
 		}
 	}
 
@@ -405,10 +402,11 @@ func lexQuoteString(lex *lexer) StateFn {
 	}
 }
 
+//lexQuoteDoubleString double quoted text is treated as string (MySQL)
 func lexQuoteDoubleString(lex *lexer) StateFn {
-	lex.next() //consume ' character
+	lex.next() //consume " character
 
-	for { //loop till reach ' charactor or EOF
+	for { //loop till reach " charactor or EOF
 		r := lex.next()
 
 		if r == eof {
@@ -420,6 +418,39 @@ func lexQuoteDoubleString(lex *lexer) StateFn {
 	}
 }
 
+//lexQuoteDoubleLiteral double quoted text is treated as identifier (ANSI SQL)
+func lexQuoteDoubleLiteral(lex *lexer) StateFn {
+	lex.next() //consume " character
+
+	for { //loop till reach " charactor or EOF
+		r := lex.next()
+
+		if r == eof {
+			return lex.errorf("Syntax error, quoted identifier not close before reach end of file")
+		} else if r == '"' {
+			lex.emit(TokenLiteral)
+			return lexText
+		}
+	}
+}
+
+//lexBracketLiteral SQL Server bracket quoted identifier; example: [name]
+func lexBracketLiteral(lex *lexer) StateFn {
+	lex.next() //consume [ character
+
+	for { //loop till reach ] charactor
+		r := lex.next()
+
+		if r == ']' {
+			lex.emit(TokenLiteral)
+			return lexText
+		} else if !isLiteralCharacter(r) {
+			return lex.errorf(
+				"Quoted literal doesn't close properly")
+		}
+	}
+}
+
 func lexLiteral(lex *lexer) StateFn {
 	r := lex.next()
 
@@ -431,8 +462,7 @@ func lexLiteral(lex *lexer) StateFn {
 				return lexText
 			} else if !isLiteralCharacter(r) {
 				return lex.errorf(
-					"Quoted literal doesn't close properly at line %d, pos %d",
-					lex.line, lex.pos)
+					"Quoted literal doesn't close properly")
 			}
 		}
 	} else {
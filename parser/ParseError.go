@@ -0,0 +1,104 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+//ParseError lexical or syntax error found in SQL text; location is pointed to offending token
+type ParseError struct {
+	Message  string   //error description without location
+	Line     int      //line number, start from 1
+	Column   int      //column number in character, start from 1
+	Offset   int      //byte offset of offending token in SQL text
+	Token    string   //offending token text; empty if end of input is reached
+	Expected []string //expected token kinds, if any
+	Snippet  string   //offending source line followed by caret line
+}
+
+func (err *ParseError) Error() string {
+	result := err.Message
+	if len(err.Expected) > 0 {
+		result += ", expect " + strings.Join(err.Expected, " or ")
+	}
+
+	result = fmt.Sprintf("%s at line %d, column %d", result, err.Line, err.Column)
+	if err.Snippet != "" {
+		result += "\n" + err.Snippet
+	}
+
+	return result
+}
+
+//newParseError create parse error located at offending token
+func newParseError(item tokenItem, format string, args ...interface{}) *ParseError {
+	return &ParseError{
+		Message:  fmt.Sprintf(format, args...),
+		Line:     item.line,
+		Offset:   item.Pos,
+		Token:    item.Value,
+		Expected: []string{}}
+}
+
+//newExpectError create parse error for unexpected token; expected is list of token kinds
+//which is valid at offending token location
+func newExpectError(item tokenItem, expected ...string) *ParseError {
+	err := newParseError(item, "unexpected %s", describeToken(item))
+	err.Expected = expected
+
+	return err
+}
+
+//describeToken get human readable token description for error message
+func describeToken(item tokenItem) string {
+	if item.Type == TokenEOF {
+		return "end of input"
+	}
+
+	return fmt.Sprintf("token %s", item.String())
+}
+
+//locateParseError compute line, column and snippet of parse error based on SQL text;
+//other error type is returned as it is
+func locateParseError(err error, input string) error {
+	if parseErr, ok := err.(*ParseError); ok {
+		parseErr.locate(input)
+	}
+
+	return err
+}
+
+//locate compute line, column and snippet from byte offset
+func (err *ParseError) locate(input string) {
+	offset := err.Offset
+	if offset < 0 {
+		offset = 0
+	} else if offset > len(input) {
+		offset = len(input)
+	}
+
+	lineStart := strings.LastIndex(input[:offset], "\n") + 1
+	lineEnd := strings.Index(input[offset:], "\n")
+	if lineEnd < 0 {
+		lineEnd = len(input)
+	} else {
+		lineEnd += offset
+	}
+	sourceLine := strings.TrimSuffix(input[lineStart:lineEnd], "\r")
+
+	//keep tab so that caret is aligned with source line
+	caret := ""
+	for _, r := range input[lineStart:offset] {
+		if r == '\t' {
+			caret += "\t"
+		} else {
+			caret += " "
+		}
+	}
+
+	err.Offset = offset
+	err.Line = strings.Count(input[:offset], "\n") + 1
+	err.Column = utf8.RuneCountInString(input[lineStart:offset]) + 1
+	err.Snippet = sourceLine + "\n" + caret + "^"
+}
@@ -0,0 +1,85 @@
+package parser
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseError(t *testing.T) {
+	_, err := ParseSQL("SELECT a.id,\n  a.name\nFROM\n  WHERE a.id = 1")
+	if err == nil {
+		t.Fatal("Expect syntax error but get nil")
+	}
+
+	parseErr, ok := err.(*ParseError)
+	if !ok {
+		t.Fatalf("Expect *ParseError but get %T", err)
+	}
+
+	if parseErr.Line != 4 || parseErr.Column != 3 || parseErr.Offset != 29 {
+		t.Errorf("Expect line 4, column 3, offset 29 but get line %d, column %d, offset %d",
+			parseErr.Line, parseErr.Column, parseErr.Offset)
+	}
+
+	if strings.Compare(parseErr.Token, "WHERE") != 0 {
+		t.Errorf("Expect offending token WHERE but get %s", parseErr.Token)
+	}
+
+	if !reflect.DeepEqual(parseErr.Expected, []string{"source"}) {
+		t.Errorf("Expect expected token kinds [source] but get %v", parseErr.Expected)
+	}
+
+	expected := "unexpected token \"WHERE\", expect source at line 4, column 3\n" +
+		"  WHERE a.id = 1\n" +
+		"  ^"
+	if strings.Compare(err.Error(), expected) != 0 {
+		t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expected, err.Error())
+	}
+}
+
+func TestParseError_location(t *testing.T) {
+	sqls := []struct {
+		sql     string
+		line    int
+		column  int
+		token   string
+		snippet string
+	}{
+		{"SELECT a FROM b WHERE (a = 1", 1, 23, "(",
+			"SELECT a FROM b WHERE (a = 1\n                      ^"},
+		{"SELECT a\nFROM b\nLIMIT", 3, 6, "",
+			"LIMIT\n     ^"},
+		{"SELECT a FROM b c d;", 1, 19, "d",
+			"SELECT a FROM b c d;\n                  ^"},
+		{"SELECT 'é' AS x, #y FROM b", 1, 18, "#",
+			"SELECT 'é' AS x, #y FROM b\n                 ^"},
+		{"SELECT a\n\tFROM b\n\tWHERE\t= 1", 3, 8, "=",
+			"\tWHERE\t= 1\n\t     \t^"},
+		{"INSERT INTO a (b)\nVALUE (1)", 2, 1, "VALUE",
+			"VALUE (1)\n^"},
+		{"CREATE TABLE a (\n  id int,\n  PRIMARY KEY id\n)", 3, 15, "id",
+			"  PRIMARY KEY id\n              ^"}}
+
+	for _, item := range sqls {
+		_, err := ParseSQL(item.sql)
+		parseErr, ok := err.(*ParseError)
+		if !ok {
+			t.Errorf("Expect *ParseError for %q but get %v", item.sql, err)
+			continue
+		}
+
+		if parseErr.Line != item.line || parseErr.Column != item.column {
+			t.Errorf("Expect %q error at line %d, column %d but get line %d, column %d",
+				item.sql, item.line, item.column, parseErr.Line, parseErr.Column)
+		}
+
+		if strings.Compare(parseErr.Token, item.token) != 0 {
+			t.Errorf("Expect %q offending token %q but get %q", item.sql, item.token, parseErr.Token)
+		}
+
+		if strings.Compare(parseErr.Snippet, item.snippet) != 0 {
+			t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", item.snippet, parseErr.Snippet)
+		}
+	}
+}
@@ -0,0 +1,624 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+)
+
+//KeywordCase letter case of SQL keyword generated by printer
+type KeywordCase uint8
+
+const (
+	//KeywordUpper print keyword in upper case; example: SELECT
+	KeywordUpper KeywordCase = iota
+	//KeywordLower print keyword in lower case; example: select
+	KeywordLower
+)
+
+//QuoteStyle identifier quoting generated by printer
+type QuoteStyle uint8
+
+const (
+	//QuoteAsIs keep identifier as it is written in source
+	QuoteAsIs QuoteStyle = iota
+	//QuoteBacktick quote identifier with backtick (MySQL); example: `name`
+	QuoteBacktick
+	//QuoteDouble quote identifier with double quote (ANSI, PostgreSQL, SQLite); example: "name"
+	QuoteDouble
+	//QuoteBracket quote identifier with square bracket (SQL Server); example: [name]
+	QuoteBracket
+)
+
+//PrintStyle SQL printer configuration
+type PrintStyle struct {
+	KeywordCase   KeywordCase
+	Quote         QuoteStyle
+	Indent        string //indentation of column list and sub-query; example: "  "
+	ColumnPerLine bool   //print every SELECT column in separate line
+}
+
+//DefaultPrintStyle upper case keyword, identifier kept as it is, two spaces indentation
+func DefaultPrintStyle() PrintStyle {
+	return PrintStyle{
+		KeywordCase:   KeywordUpper,
+		Quote:         QuoteAsIs,
+		Indent:        "  ",
+		ColumnPerLine: false}
+}
+
+//keywords canonical text of keyword tokens
+var keywords = map[TokenType]string{
+	TokenSelect:    "select",
+	TokenFrom:      "from",
+	TokenWhere:     "where",
+	TokenGroupBy:   "group by",
+	TokenOrderBy:   "order by",
+	TokenHaving:    "having",
+	TokenUnion:     "union",
+	TokenJoin:      "join",
+	TokenInnerJoin: "inner join",
+	TokenOuterJoin: "outer join",
+	TokenLeftJoin:  "left join",
+	TokenRightJoin: "right join",
+	TokenOn:        "on",
+	TokenLimit:     "limit",
+	TokenOffset:    "offset",
+	TokenAsc:       "asc",
+	TokenDesc:      "desc",
+	TokenAnd:       "and",
+	TokenOr:        "or",
+	TokenNot:       "not",
+	TokenAs:        "as",
+	TokenLike:      "like",
+	TokenBetween:   "between",
+	TokenIn:        "in",
+	TokenCreate:    "create",
+	TokenTable:     "table",
+	TokenView:      "view",
+	TokenDrop:      "drop",
+	TokenInsert:    "insert",
+	TokenUpdate:    "update",
+	TokenDelete:    "delete",
+	TokenSet:       "set",
+	TokenValues:    "values",
+	TokenInto:      "into",
+	TokenExists:    "exists",
+}
+
+//bareWords literal which is SQL keyword or constant; never quoted
+var bareWords = []string{"null", "true", "false", "is", "distinct",
+	"current_date", "current_time", "current_timestamp"}
+
+//printer render syntax tree into SQL string
+type printer struct {
+	style PrintStyle
+}
+
+//Print render syntax tree (query, CREATE VIEW, INSERT, UPDATE, DELETE or MERGE statement) into SQL string with specified style;
+//output can be parsed back into equivalent syntax tree (use ParseSQLAnsiQuotes for QuoteDouble output)
+func Print(ast *SyntaxTree, style PrintStyle) (string, error) {
+	p := &printer{style: style}
+
+	return p.node(ast)
+}
+
+//FormatSQL parse SQL string and print it with specified style
+func FormatSQL(inputText string, style PrintStyle) (string, error) {
+	ast, err := ParseSQL(inputText)
+	if err != nil {
+		return "", err
+	}
+
+	return Print(ast, style)
+}
+
+func (p *printer) node(ast *SyntaxTree) (string, error) {
+	switch ast.DataType {
+	case NodeQuery:
+		return p.query(ast)
+	case NodeQuerySelect:
+		return p.querySelect(ast)
+	case NodeCreateView:
+		return p.createView(ast)
+	case NodeInsert:
+		return p.insert(ast)
+	case NodeUpdate:
+		return p.update(ast)
+	case NodeDelete:
+		return p.deleteFrom(ast)
+	case NodeMerge:
+		return p.merge(ast)
+	default:
+		return "", fmt.Errorf("unsupported syntax tree node type (%d) to print", ast.DataType)
+	}
+}
+
+func (p *printer) query(ast *SyntaxTree) (string, error) {
+	result := ""
+	for index := range ast.ChildNodes {
+		sql, err := p.querySelect(&ast.ChildNodes[index])
+		if err != nil {
+			return "", err
+		}
+
+		if index == 0 {
+			result = sql
+		} else {
+			result = result + "\n" + p.keyword(TokenUnion) + "\n" + sql
+		}
+	}
+
+	return result, nil
+}
+
+func (p *printer) querySelect(ast *SyntaxTree) (string, error) {
+	return p.clauses(ast.ChildNodes)
+}
+
+//clauses print every clause in separate line
+func (p *printer) clauses(nodes []SyntaxTree) (string, error) {
+	lines := []string{}
+
+	for index := range nodes {
+		sql, err := p.clause(&nodes[index])
+		if err != nil {
+			return "", err
+		}
+
+		lines = append(lines, sql)
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
+
+func (p *printer) clause(node *SyntaxTree) (string, error) {
+	switch node.DataType {
+	case NodeSelect:
+		return p.selectColumns(node), nil
+	case NodeFrom:
+		return p.source(node, p.keyword(TokenFrom))
+	case NodeJoin:
+		return p.source(node, p.keyword(node.Source[node.StartPosition].Type))
+	case NodeWhere, NodeHaving:
+		return p.keyword(node.Source[node.StartPosition].Type) + " " + p.tokens(&node.ChildNodes[0]), nil
+	case NodeGroupBy, NodeOrderBy:
+		return p.orderColumns(node), nil
+	case NodeLimit:
+		return p.tokens(node), nil
+	case NodeSet:
+		return p.assignments(node), nil
+	case NodeValues:
+		return p.values(node), nil
+	case NodeUsing:
+		return p.source(node, p.word("using"))
+	case NodeOnDuplicateKey:
+		return p.word("on duplicate key") + " " + p.assignments(&node.ChildNodes[0]), nil
+	case NodeOnConflict:
+		return p.onConflict(node), nil
+	case NodeWhen:
+		return p.when(node), nil
+	default:
+		return "", fmt.Errorf("unsupported clause node type (%d) to print", node.DataType)
+	}
+}
+
+//insert print INSERT INTO <table> [(<columns>)] VALUES <rows> | <query>
+func (p *printer) insert(ast *SyntaxTree) (string, error) {
+	result := p.keyword(TokenInsert) + " " + p.keyword(TokenInto) + " " + p.tokens(&ast.ChildNodes[0])
+
+	for index := 1; index < len(ast.ChildNodes); index++ {
+		node := &ast.ChildNodes[index]
+
+		if node.DataType == NodeList {
+			result = result + " " + p.nameList(node)
+			continue
+		}
+
+		var sql string
+		var err error
+		if node.DataType == NodeQuery {
+			sql, err = p.node(node)
+		} else {
+			sql, err = p.clause(node)
+		}
+
+		if err != nil {
+			return "", err
+		}
+
+		result = result + "\n" + sql
+	}
+
+	return result, nil
+}
+
+//update print UPDATE <table> [AS <alias>] <joins> SET <assignments> <filters>
+func (p *printer) update(ast *SyntaxTree) (string, error) {
+	result := p.keyword(TokenUpdate) + " " + p.tokens(&ast.ChildNodes[0])
+	index := 1
+
+	if len(ast.ChildNodes) > index && ast.ChildNodes[index].DataType == NodeAlias {
+		alias := &ast.ChildNodes[index]
+		result = result + " " + p.keyword(TokenAs) + " " + p.token(ast.Source[alias.EndPosition])
+		index++
+	}
+
+	sql, err := p.clauses(ast.ChildNodes[index:])
+	if err != nil {
+		return "", err
+	}
+
+	return result + "\n" + sql, nil
+}
+
+//deleteFrom print DELETE [<target>] FROM <table> [USING <source>] <joins> <filters>
+func (p *printer) deleteFrom(ast *SyntaxTree) (string, error) {
+	result := p.keyword(TokenDelete)
+	nodes := ast.ChildNodes
+
+	if nodes[0].DataType == NodeIdentifier {
+		result = result + " " + p.tokens(&nodes[0])
+		nodes = nodes[1:]
+	}
+
+	sql, err := p.clauses(nodes)
+	if err != nil {
+		return "", err
+	}
+
+	return result + " " + sql, nil
+}
+
+//merge print MERGE INTO <table> [AS <alias>] USING <source> ON <condition> <when clauses>
+func (p *printer) merge(ast *SyntaxTree) (string, error) {
+	result := p.word("merge") + " " + p.keyword(TokenInto) + " " + p.tokens(&ast.ChildNodes[0])
+	index := 1
+
+	if ast.ChildNodes[index].DataType == NodeAlias {
+		alias := &ast.ChildNodes[index]
+		result = result + " " + p.keyword(TokenAs) + " " + p.token(ast.Source[alias.EndPosition])
+		index++
+	}
+
+	sql, err := p.clauses(ast.ChildNodes[index:])
+	if err != nil {
+		return "", err
+	}
+
+	return result + "\n" + sql, nil
+}
+
+//when print WHEN [NOT] MATCHED [AND <condition>] THEN <action>
+func (p *printer) when(ast *SyntaxTree) string {
+	result := p.word("when")
+	if ast.Source[ast.StartPosition+1].Type == TokenNot {
+		result = result + " " + p.keyword(TokenNot)
+	}
+	result = result + " " + p.word("matched")
+
+	if len(ast.ChildNodes) > 1 {
+		result = result + " " + p.keyword(TokenAnd) + " " + p.tokens(&ast.ChildNodes[0])
+	}
+	result = result + " " + p.word("then") + " "
+
+	action := &ast.ChildNodes[len(ast.ChildNodes)-1]
+	switch action.DataType {
+	case NodeSet:
+		return result + p.keyword(TokenUpdate) + " " + p.assignments(action)
+	case NodeDelete:
+		return result + p.keyword(TokenDelete)
+	}
+
+	result = result + p.keyword(TokenInsert)
+	for index := range action.ChildNodes {
+		if action.ChildNodes[index].DataType == NodeList {
+			result = result + " " + p.nameList(&action.ChildNodes[index])
+		} else {
+			result = result + " " + p.values(&action.ChildNodes[index])
+		}
+	}
+
+	return result
+}
+
+//onConflict print ON CONFLICT [(<columns>)] DO NOTHING | DO UPDATE SET <assignments> [WHERE <condition>]
+func (p *printer) onConflict(ast *SyntaxTree) string {
+	result := p.word("on conflict")
+	action := " " + p.word("do nothing")
+
+	for index := range ast.ChildNodes {
+		node := &ast.ChildNodes[index]
+
+		switch node.DataType {
+		case NodeList:
+			result = result + " " + p.nameList(node)
+		case NodeSet:
+			action = " " + p.word("do") + " " + p.keyword(TokenUpdate) + " " + p.assignments(node)
+		case NodeWhere:
+			action = action + " " + p.keyword(TokenWhere) + " " + p.tokens(&node.ChildNodes[0])
+		}
+	}
+
+	return result + action
+}
+
+//assignments print column assignments led by its keyword; example: SET a = 1, UPDATE of ON DUPLICATE KEY UPDATE
+func (p *printer) assignments(ast *SyntaxTree) string {
+	items := []string{}
+	for _, assignment := range ast.ChildNodes {
+		items = append(items, p.tokens(&assignment.ChildNodes[0])+" = "+p.tokens(&assignment.ChildNodes[1]))
+	}
+
+	keyword := p.token(ast.Source[ast.StartPosition])
+	if p.style.ColumnPerLine {
+		return keyword + "\n" + p.style.Indent + strings.Join(items, ",\n"+p.style.Indent)
+	}
+
+	return keyword + " " + strings.Join(items, ", ")
+}
+
+//values print VALUES rows; every row in separate line if more than one row
+func (p *printer) values(ast *SyntaxTree) string {
+	rows := []string{}
+	for _, row := range ast.ChildNodes {
+		items := []string{}
+		for index := range row.ChildNodes {
+			items = append(items, p.tokens(&row.ChildNodes[index]))
+		}
+
+		rows = append(rows, "("+strings.Join(items, ", ")+")")
+	}
+
+	if len(rows) > 1 {
+		return p.keyword(TokenValues) + "\n" + p.style.Indent + strings.Join(rows, ",\n"+p.style.Indent)
+	}
+
+	return p.keyword(TokenValues) + " " + rows[0]
+}
+
+//nameList print list of names; example: (a, b, c)
+func (p *printer) nameList(ast *SyntaxTree) string {
+	names := []string{}
+	for index := range ast.ChildNodes {
+		names = append(names, p.tokens(&ast.ChildNodes[index]))
+	}
+
+	return "(" + strings.Join(names, ", ") + ")"
+}
+
+//createView print CREATE [OR REPLACE] [TEMPORARY] VIEW [IF NOT EXISTS] <name> [(<columns>)] AS <query>
+func (p *printer) createView(ast *SyntaxTree) (string, error) {
+	result := p.keyword(TokenCreate)
+	hasKeyword := false
+
+	for index := range ast.ChildNodes {
+		node := &ast.ChildNodes[index]
+
+		//OR REPLACE and TEMPORARY go before VIEW keyword, IF NOT EXISTS go after it
+		if node.DataType == NodeCreateOption && !isWord(node.Source[node.StartPosition], "if") {
+			result = result + " " + p.word(node.Text())
+			continue
+		}
+
+		if !hasKeyword {
+			result = result + " " + p.keyword(TokenView)
+			hasKeyword = true
+		}
+
+		switch node.DataType {
+		case NodeCreateOption:
+			result = result + " " + p.word(node.Text())
+		case NodeSource:
+			result = result + " " + p.tokens(node)
+		case NodeList:
+			result = result + " " + p.nameList(node)
+		default:
+			query, err := p.node(node)
+			if err != nil {
+				return "", err
+			}
+			result = result + " " + p.keyword(TokenAs) + "\n" + query
+		}
+	}
+
+	return result, nil
+}
+
+func (p *printer) selectColumns(ast *SyntaxTree) string {
+	keyword := p.keyword(TokenSelect)
+	columns := []string{}
+	for index := range ast.ChildNodes {
+		if ast.ChildNodes[index].DataType == NodeDistinct {
+			keyword = keyword + " " + p.token(ast.Source[ast.ChildNodes[index].StartPosition])
+			continue
+		}
+
+		columns = append(columns, p.aliased(&ast.ChildNodes[index]))
+	}
+
+	if p.style.ColumnPerLine {
+		return keyword + "\n" + p.style.Indent +
+			strings.Join(columns, ",\n"+p.style.Indent)
+	}
+
+	return keyword + " " + strings.Join(columns, ", ")
+}
+
+//aliased print first child node followed by alias (if any)
+func (p *printer) aliased(ast *SyntaxTree) string {
+	result := p.tokens(&ast.ChildNodes[0])
+
+	return result + p.alias(ast)
+}
+
+//alias print " AS alias" if last child node is NodeAlias
+func (p *printer) alias(ast *SyntaxTree) string {
+	if len(ast.ChildNodes) < 2 {
+		return ""
+	}
+
+	alias := &ast.ChildNodes[len(ast.ChildNodes)-1]
+	if alias.DataType != NodeAlias {
+		return ""
+	}
+
+	return " " + p.keyword(TokenAs) + " " + p.token(ast.Source[alias.EndPosition])
+}
+
+//source print FROM / JOIN clause: <keyword> <source> [AS <alias>] [ON <condition>]
+func (p *printer) source(ast *SyntaxTree, keyword string) (string, error) {
+	src := &ast.ChildNodes[0]
+	result := keyword + " "
+
+	if len(src.ChildNodes) > 0 && src.ChildNodes[0].DataType == NodeValues {
+		result = result + "(" + p.values(&src.ChildNodes[0]) + ")"
+	} else if len(src.ChildNodes) > 0 {
+		subQuery, err := p.node(&src.ChildNodes[0])
+		if err != nil {
+			return "", err
+		}
+
+		result = result + "(\n" + p.indent(subQuery) + "\n)"
+	} else {
+		result = result + p.tokens(src)
+	}
+
+	for index := 1; index < len(ast.ChildNodes); index++ {
+		node := &ast.ChildNodes[index]
+
+		if node.DataType == NodeAlias {
+			result = result + " " + p.keyword(TokenAs) + " " + p.token(ast.Source[node.EndPosition])
+		} else if node.DataType == NodeList {
+			result = result + " " + p.nameList(node)
+		} else if node.DataType == NodeCondition {
+			result = result + " " + p.keyword(TokenOn) + " " + p.tokens(node)
+		}
+	}
+
+	return result, nil
+}
+
+//orderColumns print GROUP BY / ORDER BY column list
+func (p *printer) orderColumns(ast *SyntaxTree) string {
+	columns := []string{}
+	for _, col := range ast.ChildNodes {
+		sql := p.tokens(&col.ChildNodes[0])
+		if len(col.ChildNodes) > 1 {
+			sql = sql + " " + p.token(col.Source[col.ChildNodes[1].StartPosition])
+		}
+
+		columns = append(columns, sql)
+	}
+
+	return p.keyword(ast.Source[ast.StartPosition].Type) + " " + strings.Join(columns, ", ")
+}
+
+//indent prefix every line with indentation
+func (p *printer) indent(sql string) string {
+	if strings.Compare(p.style.Indent, "") == 0 {
+		return sql
+	}
+
+	return p.style.Indent + strings.Replace(sql, "\n", "\n"+p.style.Indent, -1)
+}
+
+//tokens print every token covered by node with normalized spacing
+func (p *printer) tokens(ast *SyntaxTree) string {
+	return printTokens(ast.Source, ast.StartPosition, ast.EndPosition,
+		func(source []tokenItem, index int) string {
+			//argument list of function must be covered by the same node
+			if index < ast.EndPosition && isFunctionCall(source, index) {
+				return p.word(source[index].Value)
+			}
+
+			return p.token(source[index])
+		})
+}
+
+//word print function name or keyword which is not a token (example: USING) with keyword case; it is never quoted
+func (p *printer) word(value string) string {
+	if p.style.KeywordCase == KeywordLower {
+		return strings.ToLower(value)
+	}
+
+	return strings.ToUpper(value)
+}
+
+//token print single token with keyword case and identifier quoting
+func (p *printer) token(item tokenItem) string {
+	if _, ok := keywords[item.Type]; ok {
+		return p.keyword(item.Type)
+	}
+
+	if item.Type == TokenLiteral {
+		return p.identifier(item.Value)
+	}
+
+	return item.Value
+}
+
+func (p *printer) keyword(tokenType TokenType) string {
+	if p.style.KeywordCase == KeywordLower {
+		return keywords[tokenType]
+	}
+
+	return strings.ToUpper(keywords[tokenType])
+}
+
+func (p *printer) identifier(value string) string {
+	name := UnquoteIdentifier(value)
+	for _, word := range bareWords {
+		if strings.EqualFold(name, word) && strings.Compare(name, value) == 0 {
+			if p.style.KeywordCase == KeywordLower {
+				return strings.ToLower(name)
+			}
+
+			return strings.ToUpper(name)
+		}
+	}
+
+	if p.style.Quote == QuoteAsIs {
+		return value
+	}
+
+	switch p.style.Quote {
+	case QuoteBacktick:
+		return "`" + name + "`"
+	case QuoteDouble:
+		return "\"" + name + "\""
+	case QuoteBracket:
+		return "[" + name + "]"
+	default:
+		return value
+	}
+}
+
+//UnquoteIdentifier remove backtick, double quote, or square bracket around identifier
+func UnquoteIdentifier(value string) string {
+	if len(value) >= 2 &&
+		((value[0] == '`' && value[len(value)-1] == '`') ||
+			(value[0] == '"' && value[len(value)-1] == '"') ||
+			(value[0] == '[' && value[len(value)-1] == ']')) {
+		return value[1 : len(value)-1]
+	}
+
+	return value
+}
+
+//printTokens join tokens from start index until end index (inclusive) with normalized spacing
+func printTokens(source []tokenItem, startIndex int, endIndex int, format func([]tokenItem, int) string) string {
+	if source == nil || startIndex < 0 || endIndex >= len(source) || startIndex > endIndex {
+		return ""
+	}
+
+	result := ""
+	for i := startIndex; i <= endIndex; i++ {
+		if i > startIndex && needSpaceBefore(source, i, startIndex) {
+			result = result + " "
+		}
+
+		result = result + format(source, i)
+	}
+
+	return result
+}
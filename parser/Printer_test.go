@@ -0,0 +1,254 @@
+package parser
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPrint(t *testing.T) {
+	sql := "select a.order_id, `b`.name customer, SUM(a.amount) AS total " +
+		"from invoice a " +
+		"join customer b on a.customer_id = b.id " +
+		"left join (select id, name from agent where is_active = 1) c on c.id = b.agent_id " +
+		"where (a.amount > -100 or a.status = 'open') and a.left_over = :left " +
+		"group by a.order_id, b.name " +
+		"having SUM(a.amount) > 1000 " +
+		"order by b.name desc " +
+		"limit 10 offset 20 " +
+		"union " +
+		"select x.order_id, x.name, x.total from archive x"
+
+	result, err := FormatSQL(sql, DefaultPrintStyle())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "SELECT a.order_id, `b`.name AS customer, SUM(a.amount) AS total\n" +
+		"FROM invoice AS a\n" +
+		"JOIN customer AS b ON a.customer_id = b.id\n" +
+		"LEFT JOIN (\n" +
+		"  SELECT id, name\n" +
+		"  FROM agent\n" +
+		"  WHERE is_active = 1\n" +
+		") AS c ON c.id = b.agent_id\n" +
+		"WHERE (a.amount > -100 OR a.status = 'open') AND a.left_over = :left\n" +
+		"GROUP BY a.order_id, b.name\n" +
+		"HAVING SUM(a.amount) > 1000\n" +
+		"ORDER BY b.name DESC\n" +
+		"LIMIT 10 OFFSET 20\n" +
+		"UNION\n" +
+		"SELECT x.order_id, x.name, x.total\n" +
+		"FROM archive AS x"
+	if strings.Compare(result, expected) != 0 {
+		t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expected, result)
+	}
+
+	style := PrintStyle{
+		KeywordCase:   KeywordLower,
+		Quote:         QuoteDouble,
+		Indent:        "\t",
+		ColumnPerLine: true}
+	result, err = FormatSQL("SELECT `a`.id, [b].name, COUNT(*) cnt FROM account a "+
+		"INNER JOIN member b ON b.id = a.id AND b.deleted_at = NULL "+
+		"GROUP BY a.id ASC LIMIT 5, 10", style)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected = "select\n" +
+		"\t\"a\".\"id\",\n" +
+		"\t\"b\".\"name\",\n" +
+		"\tcount(*) as \"cnt\"\n" +
+		"from \"account\" as \"a\"\n" +
+		"inner join \"member\" as \"b\" on \"b\".\"id\" = \"a\".\"id\" and \"b\".\"deleted_at\" = null\n" +
+		"group by \"a\".\"id\" asc\n" +
+		"limit 5, 10"
+	if strings.Compare(result, expected) != 0 {
+		t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expected, result)
+	}
+}
+
+func TestPrintRoundTrip(t *testing.T) {
+	sqls := []string{
+		"SELECT a, b FROM c",
+		"SELECT DISTINCT a, b.c AS d FROM t b WHERE b.x = 1 - -1",
+		"SELECT a.id, b.name AS customer FROM invoice a JOIN customer b ON a.customer_id = b.id " +
+			"WHERE a.amount > 10 AND (b.name LIKE 'A%' OR b.name = ?) ORDER BY b.name DESC LIMIT 3",
+		"SELECT x.id FROM (SELECT id FROM y WHERE id > 3) x " +
+			"RIGHT JOIN w ON w.id = x.id GROUP BY x.id HAVING MAX(x.id) > 1",
+		"SELECT `schema`.`table`.`col` FROM `schema`.`table`",
+		"CREATE VIEW v (a, b) AS SELECT x, y FROM z WHERE x > 1",
+		"CREATE OR REPLACE TEMPORARY VIEW IF NOT EXISTS v AS SELECT x FROM z",
+		"INSERT INTO t (a, b) VALUES (1, 'x'), (?, :b)",
+		"INSERT INTO t SELECT a, b FROM s WHERE a > 1",
+		"UPDATE t a JOIN s b ON b.id = a.id SET a.x = b.x + 1, a.y = NULL WHERE b.z = 1 LIMIT 3",
+		"DELETE FROM t a WHERE a.x < 10 ORDER BY a.x LIMIT 5",
+		"DELETE a FROM t AS a JOIN s AS b ON b.id = a.id WHERE b.x = @p1",
+		"DELETE FROM t AS a USING s AS b WHERE a.id = b.id AND b.x = $1",
+		"UPDATE a SET x = @p1 FROM t AS a JOIN s AS b ON b.id = a.id WHERE b.y > @p2",
+		"INSERT INTO t (a, b) VALUES (?, ?) ON DUPLICATE KEY UPDATE b = VALUES(b)",
+		"INSERT INTO t (a, b) VALUES ($1, $2), ($3, $4) ON CONFLICT (a) DO UPDATE SET b = EXCLUDED.b WHERE t.b IS NULL",
+		"INSERT INTO t (a, b) VALUES (?, ?) ON CONFLICT DO NOTHING",
+		"MERGE INTO t AS target USING (VALUES (@p1, @p2), (@p3, @p4)) AS source (a, b) ON target.a = source.a " +
+			"WHEN MATCHED AND source.b IS NULL THEN DELETE WHEN MATCHED THEN UPDATE SET b = source.b " +
+			"WHEN NOT MATCHED THEN INSERT (a, b) VALUES (source.a, source.b);",
+		"SELECT COUNT(DISTINCT a.id), COALESCE(SUM(a.x), 0) AS total, NOW() FROM t a GROUP BY a.y",
+		"SELECT LOWER(a.name), COUNT(*) FROM t a GROUP BY LOWER(a.name) ORDER BY COUNT(*) DESC, a.qty * a.price",
+		"SELECT a FROM t WHERE a IN (1, 2) AND b NOT IN (SELECT b FROM s) AND c BETWEEN 1 AND 5 " +
+			"AND d IS NOT NULL AND NOT (e = 1 OR f IS NULL) AND NOT EXISTS (SELECT 1 FROM s WHERE s.a = t.a)"}
+
+	styles := []PrintStyle{
+		DefaultPrintStyle(),
+		{KeywordCase: KeywordLower, Quote: QuoteBacktick, Indent: "    ", ColumnPerLine: true},
+		{KeywordCase: KeywordUpper, Quote: QuoteDouble, Indent: "", ColumnPerLine: false},
+		{KeywordCase: KeywordUpper, Quote: QuoteBracket, Indent: "  ", ColumnPerLine: true}}
+
+	for _, sql := range sqls {
+		for _, style := range styles {
+			first, err := FormatSQL(sql, style)
+			if err != nil {
+				t.Fatalf("failed to print %s: %s", sql, err.Error())
+			}
+
+			//double quoted identifier is string unless it is parsed with ANSI quotes
+			ast, err := ParseSQL(first)
+			if style.Quote == QuoteDouble {
+				ast, err = ParseSQLAnsiQuotes(first)
+			}
+			if err != nil {
+				t.Fatalf("failed to parse printed SQL:\n%s\n\n%s", first, err.Error())
+			}
+
+			second, err := Print(ast, style)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if strings.Compare(first, second) != 0 {
+				t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", first, second)
+			}
+		}
+	}
+}
+
+func TestPrint_modify(t *testing.T) {
+	style := DefaultPrintStyle()
+	style.ColumnPerLine = true
+
+	result, err := FormatSQL("update invoice a set a.amount = a.amount*2, a.remark = 'vip' where a.id = ?", style)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "UPDATE invoice AS a\n" +
+		"SET\n" +
+		"  a.amount = a.amount * 2,\n" +
+		"  a.remark = 'vip'\n" +
+		"WHERE a.id = ?"
+	if strings.Compare(result, expected) != 0 {
+		t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expected, result)
+	}
+
+	result, err = FormatSQL("insert into invoice (id, code) values (1, 'A'), (2, 'B')", style)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected = "INSERT INTO invoice (id, code)\n" +
+		"VALUES\n" +
+		"  (1, 'A'),\n" +
+		"  (2, 'B')"
+	if strings.Compare(result, expected) != 0 {
+		t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expected, result)
+	}
+}
+
+func TestPrint_distinct(t *testing.T) {
+	style := DefaultPrintStyle()
+	style.Quote = QuoteBacktick
+
+	result, err := FormatSQL("select distinct a, count(distinct b) from t", style)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "SELECT DISTINCT `a`, COUNT(DISTINCT `b`)\nFROM `t`"
+	if strings.Compare(result, expected) != 0 {
+		t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expected, result)
+	}
+}
+
+func TestPrint_createOption(t *testing.T) {
+	sqls := map[string]string{
+		"create or replace view v as select a from t":    "CREATE OR REPLACE VIEW v AS\nSELECT a\nFROM t",
+		"create temp view v (x) as select a from t":      "CREATE TEMP VIEW v (x) AS\nSELECT a\nFROM t",
+		"create view if not exists v as select a from t": "CREATE VIEW IF NOT EXISTS v AS\nSELECT a\nFROM t"}
+
+	for sql, expected := range sqls {
+		result, err := FormatSQL(sql, DefaultPrintStyle())
+		if err != nil {
+			t.Errorf("%s: %s", sql, err.Error())
+			continue
+		}
+
+		if strings.Compare(result, expected) != 0 {
+			t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expected, result)
+		}
+	}
+}
+
+func TestPrint_comment(t *testing.T) {
+	sqls := map[string]string{
+		"SELECT a FROM t WHERE x = 1 -- note":   "-",
+		"SELECT a /* total */ FROM t":           "/",
+		"SELECT a FROM t\n-- note\nWHERE x = 1": "-"}
+
+	for sql, token := range sqls {
+		_, err := FormatSQL(sql, DefaultPrintStyle())
+		parseErr, ok := err.(*ParseError)
+		if !ok {
+			t.Errorf("Expect *ParseError for %q but get %v", sql, err)
+			continue
+		}
+
+		if strings.Compare(parseErr.Token, token) != 0 {
+			t.Errorf("Expect %q error at comment but get token %q", sql, parseErr.Token)
+		}
+	}
+}
+
+func TestPrint_function(t *testing.T) {
+	style := DefaultPrintStyle()
+	style.Quote = QuoteBacktick
+
+	result, err := FormatSQL("select count (distinct a.id) cnt, date_format(max(a.created_on), '%Y-%m') month, "+
+		"ifnull(concat(a.first_name, ' ', a.last_name), 'N/A') name, now() from account a", style)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "SELECT COUNT(DISTINCT `a`.`id`) AS `cnt`, DATE_FORMAT(MAX(`a`.`created_on`), '%Y-%m') AS `month`, " +
+		"IFNULL(CONCAT(`a`.`first_name`, ' ', `a`.`last_name`), 'N/A') AS `name`, NOW()\n" +
+		"FROM `account` AS `a`"
+	if strings.Compare(result, expected) != 0 {
+		t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expected, result)
+	}
+}
+
+func TestPrint_predicate(t *testing.T) {
+	result, err := FormatSQL("select a.id from account a where a.status in ('open',:status) and a.deleted_on is null "+
+		"and a.name not like 'x%' and not (a.amount between 1 and 10 or a.id not in (select account_id from blacklist)) "+
+		"and exists (select 1 from invoice b where b.account_id = a.id)", DefaultPrintStyle())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "SELECT a.id\n" +
+		"FROM account AS a\n" +
+		"WHERE a.status IN ('open', :status) AND a.deleted_on IS NULL " +
+		"AND a.name NOT LIKE 'x%' AND NOT (a.amount BETWEEN 1 AND 10 OR a.id NOT IN (SELECT account_id FROM blacklist)) " +
+		"AND EXISTS (SELECT 1 FROM invoice b WHERE b.account_id = a.id)"
+	if strings.Compare(result, expected) != 0 {
+		t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expected, result)
+	}
+}
@@ -30,6 +30,49 @@ func (ast *SyntaxTree) RawString() string {
 	return ""
 }
 
+//Text generate compact SQL text from tokens covered by node; token spacing is
+//normalized while token value is kept as it is
+func (ast *SyntaxTree) Text() string {
+	return printTokens(ast.Source, ast.StartPosition, ast.EndPosition,
+		func(source []tokenItem, index int) string { return source[index].Value })
+}
+
+//needSpaceBefore check white space is required between token and its previous token
+func needSpaceBefore(source []tokenItem, index int, startIndex int) bool {
+	current := source[index]
+	previous := source[index-1]
+
+	switch current.Type {
+	case TokenColon, TokenRightParen, TokenDot:
+		return false
+	case TokenLeftParen:
+		if isFunctionCall(source, index-1) {
+			return false
+		}
+	}
+
+	switch previous.Type {
+	case TokenLeftParen, TokenDot:
+		return false
+	case TokenSubtract:
+		//unary minus is attached to its operand
+		return index-1 > startIndex && !isUnaryPrefix(source[index-2])
+	}
+
+	return true
+}
+
+//isUnaryPrefix check token can be followed by unary operator
+func isUnaryPrefix(item tokenItem) bool {
+	return isOperatorToken(item) ||
+		item.Type == TokenLeftParen ||
+		item.Type == TokenColon ||
+		item.Type == TokenSelect ||
+		item.Type == TokenWhere ||
+		item.Type == TokenAnd ||
+		item.Type == TokenOr
+}
+
 // NodeType identifies the type of a parse tree node.
 type NodeType int
 
@@ -94,16 +137,131 @@ const (
 	NodeOrder
 	//NodeCondition SQL consition statement
 	NodeCondition
+	//NodeCreateTable SQL create table statement
+	NodeCreateTable
+	//NodeColumnDefinition column definition of create table statement
+	NodeColumnDefinition
+	//NodeDataType column data type; example: DECIMAL(10, 2)
+	NodeDataType
+	//NodeColumnOption column option; example: NOT NULL, DEFAULT 0, AUTO_INCREMENT
+	NodeColumnOption
+	//NodePrimaryKey primary key constraint
+	NodePrimaryKey
+	//NodeUniqueKey unique key constraint
+	NodeUniqueKey
+	//NodeIndexKey index key
+	NodeIndexKey
+	//NodeForeignKey foreign key constraint
+	NodeForeignKey
+	//NodeTableOption table options after column definitions; example: ENGINE=InnoDB
+	NodeTableOption
+	//NodeCreateView SQL create view statement
+	NodeCreateView
+	//NodeInsert SQL insert statement
+	NodeInsert
+	//NodeUpdate SQL update statement
+	NodeUpdate
+	//NodeDelete SQL delete statement
+	NodeDelete
+	//NodeValues VALUES rows of insert statement
+	NodeValues
+	//NodeSet SET assignments of update statement
+	NodeSet
+	//NodeAssignment single column assignment; example: a.name = 'john'
+	NodeAssignment
+	//NodeDistinct DISTINCT quantifier of function argument or select column; example: COUNT(DISTINCT a), SELECT DISTINCT a
+	NodeDistinct
+	//NodeIn IN predicate; child nodes: operand, optional NOT operator, NodeList or NodeQuerySelect
+	NodeIn
+	//NodeBetween BETWEEN predicate; child nodes: operand, optional NOT operator, lower bound, upper bound
+	NodeBetween
+	//NodeIsNull IS NULL predicate; child nodes: operand, optional NOT operator (IS NOT NULL)
+	NodeIsNull
+	//NodeNot NOT predicate; child node is negated condition or predicate
+	NodeNot
+	//NodeExists EXISTS predicate; child node is NodeQuerySelect
+	NodeExists
+	//NodeMerge SQL merge statement; child nodes: target, optional alias, NodeUsing, NodeWhen...
+	NodeMerge
+	//NodeUsing USING source of PostgreSQL DELETE or MERGE statement
+	NodeUsing
+	//NodeWhen WHEN [NOT] MATCHED clause of merge statement; child nodes: optional NodeCondition, action
+	NodeWhen
+	//NodeOnConflict ON CONFLICT clause of insert statement; child nodes: optional NodeList, optional NodeSet, optional NodeWhere
+	NodeOnConflict
+	//NodeOnDuplicateKey ON DUPLICATE KEY UPDATE clause of insert statement; child node is NodeSet
+	NodeOnDuplicateKey
+	//NodeCreateOption modifier of create statement; example: OR REPLACE, TEMPORARY, IF NOT EXISTS
+	NodeCreateOption
 )
 
-//ParseSQL parse SQL string input into abstract syntax tree
-//currently only support query syntax
+//ParseSQL parse SQL string input into abstract syntax tree; support query (NodeQuery),
+//INSERT (NodeInsert), UPDATE (NodeUpdate), DELETE (NodeDelete), MERGE (NodeMerge), CREATE TABLE
+//(NodeCreateTable) and CREATE VIEW (NodeCreateView) statement; lexical and syntax error is returned as *ParseError;
+//double quoted text is string (MySQL)
 func ParseSQL(inputText string) (*SyntaxTree, error) {
-	tokens := tokenize(inputText)
+	return parseSQL(inputText, false)
+}
+
+//ParseSQLAnsiQuotes same as ParseSQL except double quoted text is identifier (ANSI SQL, PostgreSQL,
+//SQLite, SQL Server, or MySQL ANSI_QUOTES mode)
+func ParseSQLAnsiQuotes(inputText string) (*SyntaxTree, error) {
+	return parseSQL(inputText, true)
+}
+
+func parseSQL(inputText string, ansiQuotes bool) (*SyntaxTree, error) {
+	tokens, err := tokenizeSQL(inputText, ansiQuotes)
+	if err != nil {
+		return nil, locateParseError(err, inputText)
+	}
 
 	if tokens == nil || len(tokens) == 0 {
 		return nil, fmt.Errorf("input text has no matching token to tokenize")
 	}
 
-	return parseSelect(tokens, 0)
+	ast, err := parseStatement(tokens, 0)
+	if err != nil {
+		return nil, locateParseError(err, inputText)
+	}
+
+	if err := parseEndOfStatement(tokens, ast.EndPosition+1); err != nil {
+		return nil, locateParseError(err, inputText)
+	}
+
+	return ast, nil
+}
+
+//parseStatement parse statement based on its leading token
+func parseStatement(source []tokenItem, startIndex int) (*SyntaxTree, error) {
+	switch source[startIndex].Type {
+	case TokenCreate:
+		return parseCreate(source, startIndex)
+	case TokenInsert:
+		return parseInsert(source, startIndex)
+	case TokenUpdate:
+		return parseUpdate(source, startIndex)
+	case TokenDelete:
+		return parseDelete(source, startIndex)
+	default:
+		if isWord(source[startIndex], "merge") {
+			return parseMerge(source, startIndex)
+		}
+
+		return parseQuery(source, startIndex)
+	}
+}
+
+//parseEndOfStatement ensure no unconsumed token left after statement; statement
+//may ended with optional semicolon
+func parseEndOfStatement(source []tokenItem, startIndex int) error {
+	index := startIndex
+	if len(source) > index && source[index].Type == TokenSemiColon {
+		index++
+	}
+
+	if len(source) > index && source[index].Type != TokenEOF {
+		return newExpectError(source[index], "end of statement")
+	}
+
+	return nil
 }
@@ -0,0 +1,350 @@
+package parser
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseSQL(t *testing.T) {
+	ast, err := ParseSQL("SELECT a.order_id, b.name AS customer, SUM(a.amount) total " +
+		"FROM invoice a " +
+		"JOIN customer b ON a.customer_id = b.id " +
+		"LEFT JOIN agent c ON c.id = b.agent_id AND c.is_active = ? " +
+		"WHERE a.amount > 100 AND a.left_over = :left " +
+		"GROUP BY a.order_id, b.name " +
+		"HAVING SUM(a.amount) > 1000 " +
+		"ORDER BY b.name DESC " +
+		"LIMIT 10 OFFSET 20 " +
+		"UNION " +
+		"SELECT x.order_id, x.name, x.total FROM archive x;")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ast.DataType != NodeQuery {
+		t.Errorf("Expect NodeQuery but get %d", ast.DataType)
+	}
+
+	if len(ast.ChildNodes) != 2 {
+		t.Fatalf("Expect 2 union queries but get %d", len(ast.ChildNodes))
+	}
+
+	expectedTypes := []NodeType{NodeSelect, NodeFrom, NodeJoin, NodeJoin, NodeWhere,
+		NodeGroupBy, NodeHaving, NodeOrderBy, NodeLimit}
+	nodes := ast.ChildNodes[0].ChildNodes
+	if len(nodes) != len(expectedTypes) {
+		t.Fatalf("Expect %d clauses but get %d", len(expectedTypes), len(nodes))
+	}
+
+	for index, nodeType := range expectedTypes {
+		if nodes[index].DataType != nodeType {
+			t.Errorf("Expect clause %d is node type %d but get %d", index, nodeType, nodes[index].DataType)
+		}
+	}
+
+	invalidSQLs := []string{
+		"SELECT a FROM b WHERE",
+		"SELECT a FROM b WHERE a = 1 c",
+		"SELECT a FROM b JOIN c ON",
+		"SELECT a FROM b GROUP BY",
+		"SELECT a FROM b HAVING",
+		"SELECT a FROM b ORDER BY",
+		"SELECT a FROM b LIMIT",
+		"SELECT a FROM b UNION",
+		"SELECT a FROM b; SELECT c FROM d",
+		"SELECT a FROM (SELECT b FROM c WHERE) d",
+		"SELECT a FROM b WHERE c = 'open",
+		""}
+
+	for _, sql := range invalidSQLs {
+		if _, err := ParseSQL(sql); err == nil {
+			t.Errorf("Expect syntax error for: %s", sql)
+		}
+	}
+}
+
+func TestParseSQL_comparison(t *testing.T) {
+	sqls := []string{
+		"SELECT a FROM b WHERE a.amount >= 100 AND a.amount <= 200",
+		"SELECT a FROM b JOIN c ON c.id >= b.id AND c.id<=b.max_id",
+		"SELECT a, COUNT(*) FROM b GROUP BY a HAVING COUNT(*) >= 2",
+		"UPDATE b SET a = 1 WHERE a <= 0",
+		"DELETE FROM b WHERE a >= 10"}
+
+	for _, sql := range sqls {
+		if _, err := ParseSQL(sql); err != nil {
+			t.Errorf("%s: %s", sql, err.Error())
+		}
+	}
+}
+
+func TestParseSQL_doubleQuote(t *testing.T) {
+	//MySQL: double quoted text is string
+	if _, err := ParseSQL("SELECT a FROM t WHERE a = \"x\""); err != nil {
+		t.Error(err)
+	}
+
+	if _, err := ParseSQL("SELECT a FROM \"t\""); err == nil {
+		t.Error("Expect syntax error for double quoted table name")
+	}
+
+	//ANSI SQL: double quoted text is identifier
+	ast, err := ParseSQLAnsiQuotes("SELECT \"a\".\"id\" FROM \"t\" AS \"a\" WHERE \"a\".\"name\" = 'x'")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sql, err := Print(ast, PrintStyle{KeywordCase: KeywordUpper, Quote: QuoteBacktick})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedSQL := "SELECT `a`.`id`\nFROM `t` AS `a`\nWHERE `a`.`name` = 'x'"
+	if strings.Compare(expectedSQL, sql) != 0 {
+		t.Errorf("Expect:\n%s\n\nbut get:\n\n%s", expectedSQL, sql)
+	}
+}
+
+func TestParseSQL_nestedParenthesis(t *testing.T) {
+	sqls := map[string][]NodeType{
+		"SELECT a FROM b WHERE ((x = 1))":                       {NodeSelect, NodeFrom, NodeWhere},
+		"SELECT a FROM b WHERE ((x = 1)) AND (((y = 2)))":       {NodeSelect, NodeFrom, NodeWhere},
+		"SELECT a FROM u JOIN t ON ((u.id = t.id)) WHERE x = 1": {NodeSelect, NodeFrom, NodeJoin, NodeWhere},
+		"SELECT a FROM (u JOIN t ON ((u.id = t.id)))":           {NodeSelect, NodeFrom, NodeJoin},
+		"SELECT a FROM ((u x JOIN t y ON x.id = y.id) LEFT JOIN v z ON ((z.id = y.id))) WHERE ((x.a > 1))": {
+			NodeSelect, NodeFrom, NodeJoin, NodeJoin, NodeWhere},
+		"SELECT a FROM ((SELECT b FROM c) u JOIN t ON u.id = t.id)": {NodeSelect, NodeFrom, NodeJoin}}
+
+	for sql, expectedTypes := range sqls {
+		ast, err := ParseSQL(sql)
+		if err != nil {
+			t.Errorf("%s: %s", sql, err.Error())
+			continue
+		}
+
+		nodes := ast.ChildNodes[0].ChildNodes
+		if len(nodes) != len(expectedTypes) {
+			t.Errorf("%s: expect %d clauses but get %d", sql, len(expectedTypes), len(nodes))
+			continue
+		}
+
+		for index, nodeType := range expectedTypes {
+			if nodes[index].DataType != nodeType {
+				t.Errorf("%s: expect clause %d is node type %d but get %d", sql, index, nodeType, nodes[index].DataType)
+			}
+		}
+	}
+
+	if _, err := ParseSQL("DELETE FROM t WHERE ((id = 1))"); err != nil {
+		t.Error(err)
+	}
+
+	invalidSQLs := []string{
+		"SELECT a FROM b WHERE ((x = 1)",
+		"SELECT a FROM (u JOIN t ON u.id = t.id",
+		"SELECT a FROM (u JOIN t ON u.id = t.id) x"}
+
+	for _, sql := range invalidSQLs {
+		if _, err := ParseSQL(sql); err == nil {
+			t.Errorf("Expect syntax error for: %s", sql)
+		}
+	}
+}
+
+func TestParseSQL_createTable(t *testing.T) {
+	ast, err := ParseSQL("CREATE TABLE `invoice` (" +
+		"`id` int(10) unsigned NOT NULL AUTO_INCREMENT, " +
+		"`customer_id` int(10) REFERENCES customer (id), " +
+		"PRIMARY KEY (`id`), " +
+		"UNIQUE KEY `code` (`code`), " +
+		"KEY (`customer_id`), " +
+		"CONSTRAINT fk_customer FOREIGN KEY (`customer_id`) REFERENCES `customer` (`id`) ON DELETE CASCADE" +
+		") ENGINE=InnoDB;")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedTypes := []NodeType{NodeSource, NodeColumnDefinition, NodeColumnDefinition,
+		NodePrimaryKey, NodeUniqueKey, NodeIndexKey, NodeForeignKey, NodeTableOption}
+	if ast.DataType != NodeCreateTable {
+		t.Fatalf("Expect NodeCreateTable but get %d", ast.DataType)
+	}
+
+	if len(ast.ChildNodes) != len(expectedTypes) {
+		t.Fatalf("Expect %d nodes but get %d", len(expectedTypes), len(ast.ChildNodes))
+	}
+
+	for index, nodeType := range expectedTypes {
+		if ast.ChildNodes[index].DataType != nodeType {
+			t.Errorf("Expect node %d is node type %d but get %d", index, nodeType, ast.ChildNodes[index].DataType)
+		}
+	}
+
+	column := ast.ChildNodes[1]
+	if len(column.ChildNodes) != 4 {
+		t.Errorf("Expect column name, data type and 2 options but get %d nodes", len(column.ChildNodes))
+	} else if dataType := column.ChildNodes[1]; dataType.EndPosition-dataType.StartPosition != 4 {
+		t.Errorf("Expect data type cover 5 tokens (int(10) unsigned) but get %d",
+			dataType.EndPosition-dataType.StartPosition+1)
+	}
+
+	if ast.ChildNodes[2].ChildNodes[2].DataType != NodeForeignKey {
+		t.Errorf("Expect inline REFERENCES is NodeForeignKey")
+	}
+}
+
+func TestParseSQL_createOption(t *testing.T) {
+	sqls := map[string][]NodeType{
+		"CREATE OR REPLACE VIEW v AS SELECT a FROM t": []NodeType{
+			NodeCreateOption, NodeSource, NodeQuery},
+		"CREATE TEMPORARY TABLE IF NOT EXISTS t (a int)": []NodeType{
+			NodeCreateOption, NodeCreateOption, NodeSource, NodeColumnDefinition},
+		"CREATE OR REPLACE TEMP VIEW IF NOT EXISTS v (x) AS SELECT a FROM t": []NodeType{
+			NodeCreateOption, NodeCreateOption, NodeCreateOption, NodeSource, NodeList, NodeQuery}}
+
+	for sql, expectedTypes := range sqls {
+		ast, err := ParseSQL(sql)
+		if err != nil {
+			t.Errorf("%s: %s", sql, err.Error())
+			continue
+		}
+
+		if len(ast.ChildNodes) != len(expectedTypes) {
+			t.Errorf("Expect %d nodes but get %d: %s", len(expectedTypes), len(ast.ChildNodes), sql)
+			continue
+		}
+
+		for index, nodeType := range expectedTypes {
+			if ast.ChildNodes[index].DataType != nodeType {
+				t.Errorf("Expect node %d is node type %d but get %d: %s",
+					index, nodeType, ast.ChildNodes[index].DataType, sql)
+			}
+		}
+	}
+
+	invalidSQLs := []string{
+		"CREATE OR VIEW v AS SELECT a FROM t",
+		"CREATE TEMP OR REPLACE VIEW v AS SELECT a FROM t",
+		"CREATE VIEW IF EXISTS v AS SELECT a FROM t"}
+
+	for _, sql := range invalidSQLs {
+		if _, err := ParseSQL(sql); err == nil {
+			t.Errorf("Expect syntax error for: %s", sql)
+		}
+	}
+}
+
+func TestParseSQL_modify(t *testing.T) {
+	sqls := map[string][]NodeType{
+		"INSERT INTO invoice (id, code, amount) VALUES (1, 'A001', -2.5), (?, :code, 3 * 2)": []NodeType{
+			NodeSource, NodeList, NodeValues},
+		"INSERT invoice SELECT a.id, a.code FROM draft a WHERE a.id > 3": []NodeType{
+			NodeSource, NodeQuery},
+		"UPDATE invoice a JOIN customer b ON a.customer_id = b.id " +
+			"SET a.amount = a.amount * 2, remark = 'vip' WHERE b.grade = 'A' AND a.amount < 100 LIMIT 10": []NodeType{
+			NodeSource, NodeAlias, NodeJoin, NodeSet, NodeWhere, NodeLimit},
+		"DELETE FROM session WHERE expired_on < ? ORDER BY expired_on LIMIT 500;": []NodeType{
+			NodeFrom, NodeWhere, NodeOrderBy, NodeLimit}}
+
+	for sql, expectedTypes := range sqls {
+		ast, err := ParseSQL(sql)
+		if err != nil {
+			t.Errorf("%s: %s", sql, err.Error())
+			continue
+		}
+
+		if len(ast.ChildNodes) != len(expectedTypes) {
+			t.Errorf("Expect %d nodes but get %d: %s", len(expectedTypes), len(ast.ChildNodes), sql)
+			continue
+		}
+
+		for index, nodeType := range expectedTypes {
+			if ast.ChildNodes[index].DataType != nodeType {
+				t.Errorf("Expect node %d is node type %d but get %d: %s",
+					index, nodeType, ast.ChildNodes[index].DataType, sql)
+			}
+		}
+	}
+
+	invalidSQLs := []string{
+		"INSERT INTO invoice (id) VALUES",
+		"INSERT INTO invoice (id) VALUES (1,)",
+		"INSERT INTO invoice (id) VALUES ()",
+		"INSERT INTO invoice (id)",
+		"UPDATE invoice WHERE id = 1",
+		"UPDATE invoice SET amount WHERE id = 1",
+		"UPDATE invoice SET amount = 1 WHERE",
+		"DELETE invoice WHERE id = 1",
+		"DELETE FROM invoice WHERE id = 1 id"}
+
+	for _, sql := range invalidSQLs {
+		if _, err := ParseSQL(sql); err == nil {
+			t.Errorf("Expect syntax error for: %s", sql)
+		}
+	}
+}
+
+func TestParseSQL_modifyDialect(t *testing.T) {
+	sqls := map[string][]NodeType{
+		"DELETE a FROM invoice AS a INNER JOIN customer AS b ON a.customer_id = b.id WHERE b.is_closed = @p1": []NodeType{
+			NodeIdentifier, NodeFrom, NodeJoin, NodeWhere},
+		"DELETE FROM invoice AS a USING customer AS b WHERE a.customer_id = b.id AND (b.is_closed = $1)": []NodeType{
+			NodeFrom, NodeUsing, NodeWhere},
+		"UPDATE invoice AS a SET is_vip = $1 FROM customer AS b WHERE a.customer_id = b.id AND (b.score > $2)": []NodeType{
+			NodeSource, NodeAlias, NodeSet, NodeFrom, NodeWhere},
+		"UPDATE a SET is_vip = @p1 FROM invoice AS a INNER JOIN customer AS b ON a.customer_id = b.id WHERE b.score > @p2": []NodeType{
+			NodeSource, NodeSet, NodeFrom, NodeJoin, NodeWhere},
+		"INSERT INTO product (sku, name) VALUES (?, ?) ON DUPLICATE KEY UPDATE name = VALUES(name)": []NodeType{
+			NodeSource, NodeList, NodeValues, NodeOnDuplicateKey},
+		"INSERT INTO product (sku, name) VALUES ($1, $2) ON CONFLICT (sku) DO UPDATE SET name = EXCLUDED.name": []NodeType{
+			NodeSource, NodeList, NodeValues, NodeOnConflict},
+		"INSERT INTO product (sku, name) SELECT sku, name FROM draft ON CONFLICT DO NOTHING": []NodeType{
+			NodeSource, NodeList, NodeQuery, NodeOnConflict},
+		"MERGE INTO product AS target USING (VALUES (@p1, @p2), (@p3, @p4)) AS source (sku, name) " +
+			"ON target.sku = source.sku " +
+			"WHEN MATCHED THEN UPDATE SET name = source.name " +
+			"WHEN NOT MATCHED THEN INSERT (sku, name) VALUES (source.sku, source.name);": []NodeType{
+			NodeSource, NodeAlias, NodeUsing, NodeWhen, NodeWhen},
+		"MERGE product USING draft d ON product.sku = d.sku WHEN MATCHED AND d.deleted = 1 THEN DELETE": []NodeType{
+			NodeSource, NodeUsing, NodeWhen}}
+
+	for sql, expectedTypes := range sqls {
+		ast, err := ParseSQL(sql)
+		if err != nil {
+			t.Errorf("%s: %s", sql, err.Error())
+			continue
+		}
+
+		if len(ast.ChildNodes) != len(expectedTypes) {
+			t.Errorf("Expect %d nodes but get %d: %s", len(expectedTypes), len(ast.ChildNodes), sql)
+			continue
+		}
+
+		for index, nodeType := range expectedTypes {
+			if ast.ChildNodes[index].DataType != nodeType {
+				t.Errorf("Expect node %d is node type %d but get %d: %s",
+					index, nodeType, ast.ChildNodes[index].DataType, sql)
+			}
+		}
+	}
+
+	invalidSQLs := []string{
+		"DELETE a invoice WHERE id = 1",
+		"DELETE FROM invoice USING WHERE id = 1",
+		"UPDATE a SET x = 1 FROM WHERE id = 1",
+		"INSERT INTO t (a) VALUES (1) ON DUPLICATE UPDATE a = 1",
+		"INSERT INTO t (a) VALUES (1) ON CONFLICT (a) DO",
+		"INSERT INTO t (a) VALUES (1) ON CONFLICT (a) UPDATE SET a = 1",
+		"MERGE INTO t USING s ON t.a = s.a",
+		"MERGE INTO t USING (VALUES (1)) ON t.a = 1 WHEN MATCHED THEN DELETE",
+		"MERGE INTO t USING s ON t.a = s.a WHEN MATCHED THEN SELECT",
+		"MERGE INTO t USING s ON t.a = s.a WHEN NOT MATCHED THEN INSERT (a)",
+		"SELECT a FROM t WHERE a = $",
+		"SELECT a FROM t WHERE a = @1"}
+
+	for _, sql := range invalidSQLs {
+		if _, err := ParseSQL(sql); err == nil {
+			t.Errorf("Expect syntax error for: %s", sql)
+		}
+	}
+}
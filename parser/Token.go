@@ -42,7 +42,7 @@ const (
 	TokenString                        // quoted string; 'sample'
 	TokenNumber                        // number; -1.23 or 34.6
 	TokenParameter                     // parameter; :param1
-	TokenLiteral                       // `asd` or asd
+	TokenLiteral                       // `asd`, "asd", [asd] or asd
 	TokenSelect                        // SELECT keyword
 	TokenFrom                          // FROM keyword
 	TokenCreate                        // CREATE keyword
@@ -69,12 +69,19 @@ const (
 	TokenOr                            // OR keyword
 	TokenNot                           // not keyword
 	TokenAs                            // AS keyword
-	TokenMin                           // MIN()
+	TokenMin                           // MIN(); function tokens are no longer emitted, see isFunctionCall
 	TokenMax                           //MAX()
 	TokenGreatest                      //GREATEST()
 	TokenCount                         //COUNT()
 	TokenAvg                           //AVG()
 	TokenSum                           //SUM()
+	TokenInsert                        // INSERT keyword
+	TokenUpdate                        // UPDATE keyword
+	TokenDelete                        // DELETE keyword
+	TokenSet                           // SET keyword
+	TokenValues                        // VALUES keyword
+	TokenInto                          // INTO keyword
+	TokenExists                        // EXISTS keyword
 	//tokenDistinct               // distinct keyword
 
 )
@@ -116,6 +123,8 @@ func (item TokenType) String() string {
 		return "count"
 	case TokenCreate:
 		return "create"
+	case TokenDelete:
+		return "delete"
 	case TokenDesc:
 		return "desc"
 	// case tokenDistinct:
@@ -150,6 +159,12 @@ func (item TokenType) String() string {
 		return "in"
 	case TokenInnerJoin:
 		return "inner-join"
+	case TokenInsert:
+		return "insert"
+	case TokenInto:
+		return "into"
+	case TokenExists:
+		return "exists"
 	case TokenJoin:
 		return "join"
 	case TokenLeftJoin:
@@ -196,6 +211,8 @@ func (item TokenType) String() string {
 		return "select"
 	case TokenSemiColon:
 		return ";"
+	case TokenSet:
+		return "set"
 	case TokenString:
 		return "string"
 	case TokenSubtract:
@@ -208,6 +225,10 @@ func (item TokenType) String() string {
 		return "text"
 	case TokenUnion:
 		return "union"
+	case TokenUpdate:
+		return "update"
+	case TokenValues:
+		return "values"
 	case TokenView:
 		return "view"
 	case TokenWhere:
@@ -1,8 +1,7 @@
 package parser
 
 import (
-	"errors"
-	"fmt"
+	"strings"
 )
 
 func parseParenthesis(source []tokenItem, startIndex int) (*SyntaxTree, error) {
@@ -17,7 +16,7 @@ func parseParenthesis(source []tokenItem, startIndex int) (*SyntaxTree, error) {
 	tmpStartToken := []tokenItem{}
 
 	if source[startIndex].Type != TokenLeftParen {
-		return nil, errors.New("start token is not left parenthesis")
+		return nil, newExpectError(source[startIndex], "(")
 	}
 
 	startPos = startIndex
@@ -50,10 +49,7 @@ func parseParenthesis(source []tokenItem, startIndex int) (*SyntaxTree, error) {
 			DataType:      NodeParenthesis}, nil
 	}
 
-	return nil, fmt.Errorf(
-		"Syntax error, parenthesis is not complete at line %d, position %d",
-		tmpStartToken[len(tmpStartToken)-1].line,
-		tmpStartToken[len(tmpStartToken)-1].Pos)
+	return nil, newParseError(tmpStartToken[len(tmpStartToken)-1], "parenthesis is not closed")
 }
 
 func parseField(source []tokenItem, startIndex int) (*SyntaxTree, error) {
@@ -106,6 +102,7 @@ func parseExpresion(source []tokenItem, startIndex int) (*SyntaxTree, error) {
 	//expr = -(<expr>)
 	//expr = <operator><expr>
 	//expr = <expr><operator><expr>
+	//expr = <expr> NOT LIKE <expr>
 	//expr = <fn>
 
 	expectOperator := false
@@ -127,6 +124,18 @@ func parseExpresion(source []tokenItem, startIndex int) (*SyntaxTree, error) {
 					DataType:      NodeOperator,
 				})
 				continue
+			} else if source[i].Type == TokenNot && tokenAt(source, i+1).Type == TokenLike {
+				//negated LIKE is single operator
+				expectOperator = false
+				nodes = append(nodes, SyntaxTree{
+					ChildNodes:    []SyntaxTree{},
+					StartPosition: i,
+					EndPosition:   i + 1,
+					Source:        source,
+					DataType:      NodeOperator,
+				})
+				i++
+				continue
 			}
 
 			endIndex = i - 1
@@ -138,9 +147,10 @@ func parseExpresion(source []tokenItem, startIndex int) (*SyntaxTree, error) {
 		currentLoop := i
 
 		//check is operand or not
-		if isOperandToken(source[i]) {
+		if isOperandToken(source[i]) && !isFunctionCall(source, i) {
 
-			if source[i].Type == TokenLiteral &&
+			//qualified name; example: db.table.column
+			for source[i].Type == TokenLiteral &&
 				(i+2) < len(source) &&
 				source[i+1].Type == TokenDot &&
 				(source[i+2].Type == TokenLiteral || source[i+2].Type == TokenAsterisk) {
@@ -187,7 +197,7 @@ func parseExpresion(source []tokenItem, startIndex int) (*SyntaxTree, error) {
 			expectOperator = true
 			i = tmpExpr.EndPosition
 			continue
-		} else if isFunctionToken(source[i]) {
+		} else if isFunctionCall(source, i) {
 			funcc, funccErr := parseFunction(source, i)
 			if funccErr != nil {
 				return nil, funccErr
@@ -201,8 +211,7 @@ func parseExpresion(source []tokenItem, startIndex int) (*SyntaxTree, error) {
 			//test parse parenthesis
 			tmpBracket, bracketErr := parseParenthesis(source, i)
 			if bracketErr != nil {
-				return nil, fmt.Errorf(
-					"syntax error, incomplete parenthesis found at position %d (%s)", source[i].Pos, source[i].Value)
+				return nil, bracketErr
 			}
 
 			//try parse inner expression
@@ -211,10 +220,7 @@ func parseExpresion(source []tokenItem, startIndex int) (*SyntaxTree, error) {
 				return nil, exprErr
 			}
 			if expr2.EndPosition+1 != tmpBracket.EndPosition {
-				return nil, fmt.Errorf(
-					"expect expression ended at position %d, but get at %d instead",
-					tmpBracket.EndPosition-1,
-					expr2.EndPosition)
+				return nil, newExpectError(source[expr2.EndPosition+1], ")")
 			}
 
 			i = tmpBracket.EndPosition
@@ -231,12 +237,11 @@ func parseExpresion(source []tokenItem, startIndex int) (*SyntaxTree, error) {
 			continue
 		}
 
-		return nil, fmt.Errorf(
-			"expect to get operand token at position %d, but it is not (%s)", source[i].Pos, source[i].String())
+		return nil, newExpectError(source[i], "operand")
 	}
 
 	if endIndex == -1 {
-		return nil, fmt.Errorf("no expression syntax found from position %d", startIndex)
+		return nil, newExpectError(tokenAt(source, startIndex), "expression")
 	}
 
 	return &SyntaxTree{
@@ -249,11 +254,11 @@ func parseExpresion(source []tokenItem, startIndex int) (*SyntaxTree, error) {
 }
 
 func isOperatorToken(item tokenItem) bool {
-	return item.Type == TokenAdd || item.Type == TokenBetween ||
+	return item.Type == TokenAdd ||
 		item.Type == TokenDivide || item.Type == TokenEqual ||
 		item.Type == TokenGreater || item.Type == TokenGreaterEqual ||
 		item.Type == TokenLesser || item.Type == TokenLesserEqual ||
-		item.Type == TokenLike || item.Type == TokenNot ||
+		item.Type == TokenLike ||
 		item.Type == TokenNotEqual || item.Type == TokenSubtract ||
 		item.Type == TokenAsterisk
 }
@@ -262,58 +267,77 @@ func isOperandToken(item tokenItem) bool {
 	return item.Type == TokenLiteral ||
 		item.Type == TokenNumber ||
 		item.Type == TokenString ||
-		item.Type == TokenAsterisk
+		item.Type == TokenAsterisk ||
+		item.Type == TokenQuestionMark ||
+		item.Type == TokenParameter
 }
 
-func isFunctionToken(item tokenItem) bool {
-	return item.Type == TokenAvg ||
-		item.Type == TokenCount ||
-		//item.Type == tokenDistinct ||
-		item.Type == TokenMax ||
-		item.Type == TokenMin ||
-		item.Type == TokenSum
+//isFunctionCall check token is function name; function name is unquoted literal followed by
+//open parenthesis, example: COALESCE(a, 0), COUNT (*); VALUES(a) of MySQL ON DUPLICATE KEY UPDATE
+//is function call as well
+func isFunctionCall(source []tokenItem, index int) bool {
+	item := tokenAt(source, index)
+
+	return (item.Type == TokenValues || item.Type == TokenLiteral &&
+		strings.Compare(UnquoteIdentifier(item.Value), item.Value) == 0) &&
+		tokenAt(source, index+1).Type == TokenLeftParen
 }
 
 func parseFunction(source []tokenItem, startIndex int) (*SyntaxTree, error) {
-	//TODO: expand this function to verify various functions
-	//e.g. MAX(), MIN(), COUNT(), AVG(), etc.
-
 	//pattern:
-	//expr = min(<literal>)
-	//expr = max(<literal>)
-	//expr = count(<literal>)
-	//expr = avg(<literal>)
-	//expr = sum(<literal>)
-	//TODO: expr = if(<expr>, <expr>, <expr>)
-	if !isFunctionToken(source[startIndex]) {
-		return nil, fmt.Errorf("no function syntax found at position %d (%s)",
-			startIndex,
-			source[startIndex].String())
-	}
-
-	if len(source) < (startIndex + 1) {
-		return nil, fmt.Errorf("incomplete function syntax found at position %d", startIndex)
+	//expr = <literal>()
+	//expr = <literal>(*)
+	//expr = <literal>([DISTINCT] <args>)
+	//args = <expression>
+	//args = <expression>, <args>
+	if !isFunctionCall(source, startIndex) {
+		return nil, newExpectError(source[startIndex], "function")
 	}
 
 	paren, parenErr := parseParenthesis(source, startIndex+1)
 	if parenErr != nil {
-		return nil, fmt.Errorf("no complete parenthesis found at position %d", startIndex+1)
+		return nil, parenErr
 	}
 
-	expr, exprErr := parseExpresion(source, paren.StartPosition+1)
-	if exprErr != nil {
-		return nil, exprErr
+	nodes := []SyntaxTree{SyntaxTree{
+		ChildNodes:    []SyntaxTree{},
+		StartPosition: startIndex,
+		EndPosition:   startIndex,
+		Source:        source,
+		DataType:      NodeIdentifier,
+	}}
+
+	index := paren.StartPosition + 1
+	if isWord(source[index], "distinct") {
+		nodes = append(nodes, SyntaxTree{
+			ChildNodes:    []SyntaxTree{},
+			StartPosition: index,
+			EndPosition:   index,
+			Source:        source,
+			DataType:      NodeDistinct,
+		})
+		index++
 	}
 
-	if paren.EndPosition != (expr.EndPosition + 1) {
-		return nil, fmt.Errorf(
-			"expect after expression (position %d) will follow by close parenthesis (position %d)",
-			expr.EndPosition,
-			paren.EndPosition)
+	//function without argument (example: NOW()) is skipped
+	for len(nodes) > 1 || index != paren.EndPosition {
+		expr, exprErr := parseExpresion(source, index)
+		if exprErr != nil {
+			return nil, exprErr
+		}
+		nodes = append(nodes, *expr)
+		index = expr.EndPosition + 1
+
+		if index == paren.EndPosition {
+			break
+		} else if source[index].Type != TokenColon {
+			return nil, newExpectError(source[index], ",", ")")
+		}
+		index++
 	}
 
 	return &SyntaxTree{
-		ChildNodes:    []SyntaxTree{*expr},
+		ChildNodes:    nodes,
 		StartPosition: startIndex,
 		EndPosition:   paren.EndPosition,
 		Source:        source,
@@ -341,14 +365,12 @@ func parseJoin(source []tokenItem, startIndex int) (*SyntaxTree, error) {
 
 	tmp := source[startIndex]
 	if !isJoinToken(tmp) {
-		return nil, fmt.Errorf("Expect position %d is Join token but it is not", tmp.Pos)
+		return nil, newExpectError(tmp, "JOIN")
 	}
 
 	//source
 	if sourceLen <= (startIndex + 1) {
-		return nil, fmt.Errorf("incomplete join syntax at position %d (%s)",
-			source[startIndex].Pos,
-			source[startIndex].String())
+		return nil, newExpectError(tokenAt(source, startIndex+1), "join source")
 	}
 	tmp1 := source[startIndex+1]
 	if tmp1.Type == TokenLiteral {
@@ -379,12 +401,7 @@ func parseJoin(source []tokenItem, startIndex int) (*SyntaxTree, error) {
 		}
 
 		if query.EndPosition+1 != bracket.EndPosition {
-			return nil, fmt.Errorf(
-				"Expect query select ended at index %d (%s), but %d (%s) instead",
-				bracket.EndPosition,
-				source[bracket.EndPosition].String(),
-				query.EndPosition,
-				source[query.EndPosition].String())
+			return nil, newExpectError(source[query.EndPosition+1], ")")
 		}
 
 		endPos = bracket.EndPosition
@@ -397,10 +414,7 @@ func parseJoin(source []tokenItem, startIndex int) (*SyntaxTree, error) {
 			DataType:      NodeSource,
 		})
 	} else {
-		return nil, fmt.Errorf(
-			"syntax error found (no join source) next to JOIN token at position %d (%s)",
-			tmp1.Pos,
-			tmp1.String())
+		return nil, newExpectError(tmp1, "join source")
 	}
 
 	//source alias
@@ -456,6 +470,33 @@ func parseJoin(source []tokenItem, startIndex int) (*SyntaxTree, error) {
 	}, nil
 }
 
+//isJoinGroup check parenthesis at index wraps source and its joins, example:
+//FROM ((a JOIN b ON ...) JOIN c ON ...) as stored by MySQL and PostgreSQL view
+func isJoinGroup(source []tokenItem, index int) bool {
+	if tokenAt(source, index).Type != TokenLeftParen {
+		return false
+	}
+
+	paren, err := parseParenthesis(source, index)
+	if err != nil {
+		return false
+	}
+
+	depth := 0
+	for i := index + 1; i < paren.EndPosition; i++ {
+		switch {
+		case source[i].Type == TokenLeftParen:
+			depth++
+		case source[i].Type == TokenRightParen:
+			depth--
+		case depth == 0 && isJoinToken(source[i]):
+			return true
+		}
+	}
+
+	return false
+}
+
 func isJoinToken(token tokenItem) bool {
 	return token.Type == TokenJoin || token.Type == TokenLeftJoin ||
 		token.Type == TokenInnerJoin || token.Type == TokenOuterJoin ||
@@ -464,8 +505,7 @@ func isJoinToken(token tokenItem) bool {
 
 func parseCondition(source []tokenItem, startIndex int) (*SyntaxTree, error) {
 	//pattern:
-	//expr = <expression>
-	//expr = (<expr>)
+	//expr = <predicate>
 	//expr = <expr> AND <expr>
 	//expr = <expr> OR <expr>
 
@@ -496,52 +536,16 @@ func parseCondition(source []tokenItem, startIndex int) (*SyntaxTree, error) {
 			break
 		}
 
-		if source[i].Type == TokenLeftParen { //check is parenthesis or not
-			paren, parenErr := parseParenthesis(source, i)
-			if parenErr != nil {
-				return nil, parenErr
-			}
-
-			subCond, condErr := parseCondition(source, i+1)
-			if condErr != nil {
-				return nil, condErr
-			}
-
-			if paren.EndPosition != subCond.EndPosition+1 {
-				return nil, fmt.Errorf(
-					"sub condition expression not ended at %d, but %d (%s)",
-					paren.EndPosition-1,
-					subCond.EndPosition,
-					source[subCond.EndPosition].String())
-			}
-
-			checkCondSymbol = true
-			i = paren.EndPosition
-			endIndex = paren.EndPosition
-
-			//trim over-nested nodes
-			if len(subCond.ChildNodes) == 1 && subCond.ChildNodes[0].DataType == NodeExpression {
-				subCond = &subCond.ChildNodes[0]
-			}
-
-			nodes = append(nodes, *subCond)
-			continue
-		}
-
-		expr, exprErr := parseExpresion(source, i)
-		if exprErr == nil { //check is expression or not
-			checkCondSymbol = true
-			i = expr.EndPosition
-			endIndex = expr.EndPosition
-			nodes = append(nodes, *expr)
-			continue
+		//sub condition, predicate, or expression
+		predicate, predicateErr := parsePredicate(source, i)
+		if predicateErr != nil {
+			return nil, predicateErr
 		}
 
-		//syntax error, return error
-		return nil, fmt.Errorf(
-			"Expect expression syntax at position %d (%s)",
-			source[i].Pos,
-			source[i].String())
+		checkCondSymbol = true
+		i = predicateEnd(source, i, predicate)
+		endIndex = i
+		nodes = append(nodes, *predicate)
 	}
 
 	return &SyntaxTree{
@@ -555,19 +559,27 @@ func parseCondition(source []tokenItem, startIndex int) (*SyntaxTree, error) {
 
 func parseSelect(source []tokenItem, startIndex int) (*SyntaxTree, error) {
 	//pattern:
-	//expr = SELECT <cols>
+	//expr = SELECT [DISTINCT] <cols>
 	//cols = <expression>
 	//cols = <expression>, <cols>
 	if source[startIndex].Type != TokenSelect {
-		return nil, fmt.Errorf(
-			"Expect token SELECT but get %s instead at position %d",
-			source[startIndex].String(),
-			source[startIndex].Pos)
+		return nil, newExpectError(source[startIndex], "SELECT")
 	}
 
 	index := startIndex + 1
 	nodes := []SyntaxTree{}
 
+	if isWord(tokenAt(source, index), "distinct") {
+		nodes = append(nodes, SyntaxTree{
+			ChildNodes:    []SyntaxTree{},
+			StartPosition: index,
+			EndPosition:   index,
+			Source:        source,
+			DataType:      NodeDistinct,
+		})
+		index++
+	}
+
 	checkColon := false
 	for i := index; i < len(source); i++ {
 		if checkColon == true {
@@ -636,13 +648,10 @@ func parseSelect(source []tokenItem, startIndex int) (*SyntaxTree, error) {
 						continue
 					}
 
-					return nil, fmt.Errorf(
-						"Expect alias name after token AS but found %s; position %d",
-						source[i+2].String(),
-						source[i+2].Pos)
+					return nil, newExpectError(source[i+2], "alias name")
 				}
 
-				return nil, fmt.Errorf("syntax error; unexpected content ended with token AS")
+				return nil, newExpectError(tokenAt(source, i+2), "alias name")
 			}
 		}
 
@@ -673,15 +682,24 @@ func parseFrom(source []tokenItem, startIndex int) (*SyntaxTree, error) {
 	//src = <literal>.<literal>
 	//src = <selectExpr>
 	if source[startIndex].Type != TokenFrom {
-		return nil, fmt.Errorf(
-			"Syntax error expect token SELECT at position %d", source[startIndex].Pos)
+		return nil, newExpectError(source[startIndex], "FROM")
 	}
 
+	return parseSourceClause(source, startIndex, NodeFrom)
+}
+
+//parseSourceClause parse source and its alias which is led by keyword at startIndex; example: FROM, USING
+func parseSourceClause(source []tokenItem, startIndex int, nodeType NodeType) (*SyntaxTree, error) {
 	nodes := []SyntaxTree{}
 	index := startIndex + 1
 	var bracket *SyntaxTree
 	var fromSource *SyntaxTree
 
+	//skip join group parenthesis, it is closed by parseQuerySelect
+	for isJoinGroup(source, index) {
+		index++
+	}
+
 	if len(source) > index && source[index].Type == TokenLeftParen {
 		tmp, bracketErr := parseParenthesis(source, index)
 		if bracketErr != nil {
@@ -692,7 +710,12 @@ func parseFrom(source []tokenItem, startIndex int) (*SyntaxTree, error) {
 		index++
 	}
 
-	if expr, exprErr := parseQuerySelect(source, index); exprErr == nil {
+	if len(source) > index && source[index].Type == TokenSelect {
+		expr, exprErr := parseQuerySelect(source, index)
+		if exprErr != nil {
+			return nil, exprErr
+		}
+
 		fromSource = &SyntaxTree{
 			ChildNodes:    []SyntaxTree{*expr},
 			StartPosition: expr.StartPosition,
@@ -708,7 +731,7 @@ func parseFrom(source []tokenItem, startIndex int) (*SyntaxTree, error) {
 		index += 2
 		fromSource = &SyntaxTree{
 			ChildNodes:    []SyntaxTree{},
-			StartPosition: startIndex + 1,
+			StartPosition: index - 2,
 			EndPosition:   index,
 			Source:        source,
 			DataType:      NodeSource,
@@ -722,14 +745,11 @@ func parseFrom(source []tokenItem, startIndex int) (*SyntaxTree, error) {
 			DataType:      NodeSource,
 		}
 	} else {
-		return nil, fmt.Errorf(
-			"syntax error; no source found for FROM token at position %d", source[startIndex+1].Pos)
+		return nil, newExpectError(tokenAt(source, index), "source")
 	}
 
 	if bracket != nil && fromSource.EndPosition+1 != bracket.EndPosition {
-		return nil, fmt.Errorf("expect inner query ended next to close bracket at position %d (%s)",
-			source[fromSource.EndPosition].Pos,
-			source[fromSource.EndPosition].String())
+		return nil, newExpectError(source[fromSource.EndPosition+1], ")")
 	}
 
 	nodes = append(nodes, *fromSource)
@@ -737,8 +757,8 @@ func parseFrom(source []tokenItem, startIndex int) (*SyntaxTree, error) {
 		index++
 	}
 
-	//check for alias
-	if len(source) > index+1 && source[index+1].Type == TokenLiteral {
+	//check for alias; USING is PostgreSQL DELETE clause rather than alias
+	if len(source) > index+1 && source[index+1].Type == TokenLiteral && !isWord(source[index+1], "using") {
 		index++
 		nodes = append(nodes, SyntaxTree{
 			ChildNodes:    []SyntaxTree{},
@@ -765,7 +785,7 @@ func parseFrom(source []tokenItem, startIndex int) (*SyntaxTree, error) {
 		StartPosition: startIndex,
 		EndPosition:   index,
 		Source:        source,
-		DataType:      NodeFrom,
+		DataType:      nodeType,
 	}, nil
 }
 
@@ -774,8 +794,7 @@ func parseWhere(source []tokenItem, startIndex int) (*SyntaxTree, error) {
 	//expr = WHERE <condition>
 
 	if source[startIndex].Type != TokenWhere {
-		return nil, fmt.Errorf(
-			"Expect token WHERE found at position %d", source[startIndex].Pos)
+		return nil, newExpectError(source[startIndex], "WHERE")
 	}
 
 	index := startIndex + 1
@@ -797,15 +816,15 @@ func parseWhere(source []tokenItem, startIndex int) (*SyntaxTree, error) {
 func parseGroupBy(source []tokenItem, startIndex int) (*SyntaxTree, error) {
 	//pattern
 	//expr = GROUP BY <cols>
-	//cols = <col>, <cols>
-	//cols = <col> <order>, <cols>
-	//cols = <col>
-	//cols = <col> <order>
+	//cols = <expression>, <cols>
+	//cols = <expression> <order>, <cols>
+	//cols = <expression>
+	//cols = <expression> <order>
 	//order = ASC
 	//order = DESC
 
 	if source[startIndex].Type != TokenGroupBy {
-		return nil, fmt.Errorf("Expect token GROUP BY at position %d", source[startIndex].Pos)
+		return nil, newExpectError(source[startIndex], "GROUP BY")
 	}
 
 	srcLen := len(source)
@@ -819,32 +838,12 @@ func parseGroupBy(source []tokenItem, startIndex int) (*SyntaxTree, error) {
 		col = nil
 		order = nil
 
-		if srcLen > index+2 &&
-			source[index].Type == TokenLiteral &&
-			source[index+1].Type == TokenDot &&
-			source[index+2].Type == TokenLiteral {
-			col = &SyntaxTree{
-				ChildNodes:    []SyntaxTree{},
-				StartPosition: index,
-				EndPosition:   index + 2,
-				Source:        source,
-				DataType:      NodeColName,
-			}
-			index += 2
-		} else if srcLen > index && source[index].Type == TokenLiteral {
-			col = &SyntaxTree{
-				ChildNodes:    []SyntaxTree{},
-				StartPosition: index,
-				EndPosition:   index,
-				Source:        source,
-				DataType:      NodeColName,
-			}
-		} else {
-			return nil, fmt.Errorf(
-				"Syntax error, no matching column clause found at %d (%s)",
-				source[index].Pos,
-				source[index].String())
+		var err error
+		col, err = parseOrderExpression(source, index)
+		if err != nil {
+			return nil, err
 		}
+		index = col.EndPosition
 
 		//check for order token
 		if srcLen > (index+1) &&
@@ -906,7 +905,7 @@ func parseOrderBy(source []tokenItem, startIndex int) (*SyntaxTree, error) {
 	//order = ASC
 	//order = DESC
 	if source[startIndex].Type != TokenOrderBy {
-		return nil, fmt.Errorf("Expect token ORDER BY at position %d", source[startIndex].Pos)
+		return nil, newExpectError(source[startIndex], "ORDER BY")
 	}
 
 	srcLen := len(source)
@@ -920,32 +919,12 @@ func parseOrderBy(source []tokenItem, startIndex int) (*SyntaxTree, error) {
 		col = nil
 		order = nil
 
-		if srcLen > index+2 &&
-			source[index].Type == TokenLiteral &&
-			source[index+1].Type == TokenDot &&
-			source[index+2].Type == TokenLiteral {
-			col = &SyntaxTree{
-				ChildNodes:    []SyntaxTree{},
-				StartPosition: index,
-				EndPosition:   index + 2,
-				Source:        source,
-				DataType:      NodeColName,
-			}
-			index += 2
-		} else if srcLen > index && source[index].Type == TokenLiteral {
-			col = &SyntaxTree{
-				ChildNodes:    []SyntaxTree{},
-				StartPosition: index,
-				EndPosition:   index,
-				Source:        source,
-				DataType:      NodeColumn,
-			}
-		} else {
-			return nil, fmt.Errorf(
-				"Syntax error, no matching column clause found at %d (%s)",
-				source[index].Pos,
-				source[index].String())
+		var err error
+		col, err = parseOrderExpression(source, index)
+		if err != nil {
+			return nil, err
 		}
+		index = col.EndPosition
 
 		//check for order token
 		if srcLen > (index+1) &&
@@ -997,12 +976,32 @@ func parseOrderBy(source []tokenItem, startIndex int) (*SyntaxTree, error) {
 	}, nil
 }
 
+//parseOrderExpression parse GROUP BY or ORDER BY item into NodeColName for plain column name,
+//otherwise into function or expression node, example: COUNT(*), LOWER(a), a.qty * a.price
+func parseOrderExpression(source []tokenItem, startIndex int) (*SyntaxTree, error) {
+	expr, err := parseExpresion(source, startIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(expr.ChildNodes) != 1 {
+		return expr, nil
+	}
+
+	item := &expr.ChildNodes[0]
+	if item.DataType == NodeOperand && source[item.StartPosition].Type == TokenLiteral {
+		item.DataType = NodeColName
+	}
+
+	return item, nil
+}
+
 func parseHaving(source []tokenItem, startIndex int) (*SyntaxTree, error) {
 	//pattern
 	//expr = HAVING <condition>
 
 	if source[startIndex].Type != TokenHaving {
-		return nil, fmt.Errorf("expect token HAVING at position %d", source[startIndex].Pos)
+		return nil, newExpectError(source[startIndex], "HAVING")
 	}
 
 	cond, condErr := parseCondition(source, startIndex+1)
@@ -1026,7 +1025,7 @@ func parseLimit(source []tokenItem, startIndex int) (*SyntaxTree, error) {
 	//expr = LIMIT <integer> OFFSET <integer>
 
 	if source[startIndex].Type != TokenLimit {
-		return nil, fmt.Errorf("Expect token LIMIT at position %d", source[startIndex].Pos)
+		return nil, newExpectError(source[startIndex], "LIMIT")
 	}
 
 	sourceLen := len(source)
@@ -1067,8 +1066,7 @@ func parseLimit(source []tokenItem, startIndex int) (*SyntaxTree, error) {
 		}, nil
 	}
 
-	return nil, fmt.Errorf(
-		"Synxtax error for token LIMIT at position %d", source[startIndex].Pos)
+	return nil, newExpectError(tokenAt(source, startIndex+1), "number")
 }
 
 func parseQuerySelect(source []tokenItem, startIndex int) (*SyntaxTree, error) {
@@ -1087,9 +1085,7 @@ func parseQuerySelect(source []tokenItem, startIndex int) (*SyntaxTree, error) {
 
 	//try parse FROM statement
 	if len(source) <= (index + 1) {
-		return nil, fmt.Errorf(
-			"incomplete SELECT statement found at position %d",
-			index)
+		return nil, newExpectError(tokenAt(source, index+1), "FROM")
 	}
 	fromSyntax, fromSyntaxErr := parseFrom(source, index+1)
 	if fromSyntaxErr != nil {
@@ -1098,63 +1094,88 @@ func parseQuerySelect(source []tokenItem, startIndex int) (*SyntaxTree, error) {
 	nodes = append(nodes, *fromSyntax)
 	index = fromSyntax.EndPosition
 
-	//****** optional statements
-	//parse JOIN statement
-	if len(source) > index+1 && (source[index+1].Type == TokenJoin ||
-		source[index+1].Type == TokenLeftJoin ||
-		source[index+1].Type == TokenInnerJoin ||
-		source[index+1].Type == TokenOuterJoin ||
-		source[index+1].Type == TokenRightJoin) {
-		joinAST, joinErr := parseJoin(source, index+1)
-		if joinErr == nil {
+	//join group parenthesis skipped by parseFrom
+	groups := 0
+	for isJoinGroup(source, fromSyntax.StartPosition+1+groups) {
+		groups++
+	}
+
+	//****** optional statements; syntax error of present clause is reported
+	//parse JOIN statement(s); join group is flattened as joins are evaluated from left to right
+	for len(source) > index+1 {
+		if isJoinToken(source[index+1]) {
+			joinAST, joinErr := parseJoin(source, index+1)
+			if joinErr != nil {
+				return nil, joinErr
+			}
+
 			nodes = append(nodes, *joinAST)
 			index = joinAST.EndPosition
+		} else if groups > 0 && source[index+1].Type == TokenRightParen {
+			groups--
+			index++
+		} else {
+			break
 		}
 	}
 
+	if groups > 0 {
+		return nil, newExpectError(tokenAt(source, index+1), "JOIN", ")")
+	}
+
 	//parse WHERE statement
 	if len(source) > (index+1) && source[index+1].Type == TokenWhere {
 		whereSyntax, whereSyntaxErr := parseWhere(source, index+1)
-		if whereSyntaxErr == nil {
-			nodes = append(nodes, *whereSyntax)
-			index = whereSyntax.EndPosition
+		if whereSyntaxErr != nil {
+			return nil, whereSyntaxErr
 		}
+
+		nodes = append(nodes, *whereSyntax)
+		index = whereSyntax.EndPosition
 	}
 
 	//group by
 	if len(source) > (index+1) && source[index+1].Type == TokenGroupBy {
 		groupbyAST, groupbyASTErr := parseGroupBy(source, index+1)
-		if groupbyASTErr == nil {
-			nodes = append(nodes, *groupbyAST)
-			index = groupbyAST.EndPosition
+		if groupbyASTErr != nil {
+			return nil, groupbyASTErr
 		}
+
+		nodes = append(nodes, *groupbyAST)
+		index = groupbyAST.EndPosition
 	}
 
 	//having
 	if len(source) > (index+1) && source[index+1].Type == TokenHaving {
 		havingAST, havingErr := parseHaving(source, index+1)
-		if havingErr == nil {
-			nodes = append(nodes, *havingAST)
-			index = havingAST.EndPosition
+		if havingErr != nil {
+			return nil, havingErr
 		}
+
+		nodes = append(nodes, *havingAST)
+		index = havingAST.EndPosition
 	}
 
 	//order by
 	if len(source) > (index+1) && source[index+1].Type == TokenOrderBy {
 		orderbyAST, orderbyASTErr := parseOrderBy(source, index+1)
-		if orderbyASTErr == nil {
-			nodes = append(nodes, *orderbyAST)
-			index = orderbyAST.EndPosition
+		if orderbyASTErr != nil {
+			return nil, orderbyASTErr
 		}
+
+		nodes = append(nodes, *orderbyAST)
+		index = orderbyAST.EndPosition
 	}
 
 	//limit
 	if len(source) > (index+1) && source[index+1].Type == TokenLimit {
 		limitAST, limitASTErr := parseLimit(source, index+1)
-		if limitASTErr == nil {
-			nodes = append(nodes, *limitAST)
-			index = limitAST.EndPosition
+		if limitASTErr != nil {
+			return nil, limitASTErr
 		}
+
+		nodes = append(nodes, *limitAST)
+		index = limitAST.EndPosition
 	}
 
 	return &SyntaxTree{
@@ -1196,8 +1217,7 @@ func parseQuery(source []tokenItem, startIndex int) (*SyntaxTree, error) {
 				continue
 			}
 
-			return nil, fmt.Errorf(
-				"incomplete syntax found at position %d", source[i].Pos)
+			return nil, newExpectError(tokenAt(source, i+1), "SELECT")
 		}
 
 		break
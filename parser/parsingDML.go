@@ -0,0 +1,626 @@
+package parser
+
+func parseInsert(source []tokenItem, startIndex int) (*SyntaxTree, error) {
+	//pattern:
+	//expr = INSERT [INTO] <src> [(<columns>)] VALUES <rows> [<upsert>]
+	//expr = INSERT [INTO] <src> [(<columns>)] <query> [<upsert>]
+	//rows = (<expression>[, <expression> ...])
+	//rows = (<expression>[, <expression> ...]), <rows>
+	if source[startIndex].Type != TokenInsert {
+		return nil, newExpectError(source[startIndex], "INSERT")
+	}
+
+	index := startIndex + 1
+	if tokenAt(source, index).Type == TokenInto {
+		index++
+	}
+
+	nodes := []SyntaxTree{}
+	table, err := parseObjectName(source, index)
+	if err != nil {
+		return nil, err
+	}
+	nodes = append(nodes, *table)
+	index = table.EndPosition + 1
+
+	if tokenAt(source, index).Type == TokenLeftParen {
+		columns, err := parseNameList(source, index)
+		if err != nil {
+			return nil, err
+		}
+
+		nodes = append(nodes, *columns)
+		index = columns.EndPosition + 1
+	}
+
+	item := tokenAt(source, index)
+	switch item.Type {
+	case TokenValues:
+		values, err := parseValues(source, index)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, *values)
+		index = values.EndPosition
+	case TokenSelect:
+		query, err := parseQuery(source, index)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, *query)
+		index = query.EndPosition
+	default:
+		return nil, newExpectError(item, "VALUES", "SELECT")
+	}
+
+	if tokenAt(source, index+1).Type == TokenOn {
+		upsert, err := parseUpsert(source, index+1)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, *upsert)
+		index = upsert.EndPosition
+	}
+
+	return &SyntaxTree{
+		ChildNodes:    nodes,
+		StartPosition: startIndex,
+		EndPosition:   index,
+		Source:        source,
+		DataType:      NodeInsert,
+	}, nil
+}
+
+func parseUpsert(source []tokenItem, startIndex int) (*SyntaxTree, error) {
+	//pattern:
+	//expr = ON DUPLICATE KEY UPDATE <assignment>[, <assignment> ...]
+	//expr = ON CONFLICT [(<columns>)] DO NOTHING
+	//expr = ON CONFLICT [(<columns>)] DO UPDATE <setExpr> [<whereExpr>]
+	index := startIndex + 1
+	if isWord(tokenAt(source, index), "duplicate") {
+		if !isWord(tokenAt(source, index+1), "key") {
+			return nil, newExpectError(tokenAt(source, index+1), "KEY")
+		}
+
+		if tokenAt(source, index+2).Type != TokenUpdate {
+			return nil, newExpectError(tokenAt(source, index+2), "UPDATE")
+		}
+
+		set, err := parseAssignments(source, index+2)
+		if err != nil {
+			return nil, err
+		}
+
+		return &SyntaxTree{
+			ChildNodes:    []SyntaxTree{*set},
+			StartPosition: startIndex,
+			EndPosition:   set.EndPosition,
+			Source:        source,
+			DataType:      NodeOnDuplicateKey,
+		}, nil
+	}
+
+	if !isWord(tokenAt(source, index), "conflict") {
+		return nil, newExpectError(tokenAt(source, index), "DUPLICATE", "CONFLICT")
+	}
+
+	nodes := []SyntaxTree{}
+	index++
+	if tokenAt(source, index).Type == TokenLeftParen {
+		columns, err := parseNameList(source, index)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, *columns)
+		index = columns.EndPosition + 1
+	}
+
+	if !isWord(tokenAt(source, index), "do") {
+		return nil, newExpectError(tokenAt(source, index), "DO")
+	}
+	index++
+
+	if tokenAt(source, index).Type == TokenUpdate {
+		set, err := parseSet(source, index+1)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, *set)
+		index = set.EndPosition
+
+		if tokenAt(source, index+1).Type == TokenWhere {
+			whereAST, err := parseWhere(source, index+1)
+			if err != nil {
+				return nil, err
+			}
+			nodes = append(nodes, *whereAST)
+			index = whereAST.EndPosition
+		}
+	} else if !isWord(tokenAt(source, index), "nothing") {
+		return nil, newExpectError(tokenAt(source, index), "NOTHING", "UPDATE")
+	}
+
+	return &SyntaxTree{
+		ChildNodes:    nodes,
+		StartPosition: startIndex,
+		EndPosition:   index,
+		Source:        source,
+		DataType:      NodeOnConflict,
+	}, nil
+}
+
+func parseValues(source []tokenItem, startIndex int) (*SyntaxTree, error) {
+	//pattern:
+	//expr = VALUES <row>[, <row> ...]
+	//row = (<expression>[, <expression> ...])
+	nodes := []SyntaxTree{}
+	index := startIndex
+
+	for {
+		row, err := parseExpressionList(source, index+1)
+		if err != nil {
+			return nil, err
+		}
+
+		nodes = append(nodes, *row)
+		index = row.EndPosition
+
+		if tokenAt(source, index+1).Type != TokenColon {
+			break
+		}
+		index++
+	}
+
+	return &SyntaxTree{
+		ChildNodes:    nodes,
+		StartPosition: startIndex,
+		EndPosition:   index,
+		Source:        source,
+		DataType:      NodeValues,
+	}, nil
+}
+
+func parseUpdate(source []tokenItem, startIndex int) (*SyntaxTree, error) {
+	//pattern:
+	//expr = UPDATE <src> [[AS] <alias>] [<joinExpr> ...] <setExpr> [<fromExpr> [<joinExpr> ...]] [<whereExpr>] [<orderbyExpr>] [<limitExpr>]
+	if source[startIndex].Type != TokenUpdate {
+		return nil, newExpectError(source[startIndex], "UPDATE")
+	}
+
+	nodes := []SyntaxTree{}
+	table, err := parseObjectName(source, startIndex+1)
+	if err != nil {
+		return nil, err
+	}
+	nodes = append(nodes, *table)
+	index := table.EndPosition
+
+	if alias := parseAlias(source, index+1); alias != nil {
+		nodes = append(nodes, *alias)
+		index = alias.EndPosition
+	}
+
+	nodes, index, err = parseJoins(source, index, nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	set, err := parseSet(source, index+1)
+	if err != nil {
+		return nil, err
+	}
+	nodes = append(nodes, *set)
+	index = set.EndPosition
+
+	//PostgreSQL, SQLite and SQL Server join source
+	if tokenAt(source, index+1).Type == TokenFrom {
+		from, err := parseFrom(source, index+1)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, *from)
+
+		nodes, index, err = parseJoins(source, from.EndPosition, nodes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return parseFilterClauses(source, startIndex, index, nodes, NodeUpdate)
+}
+
+//parseAlias parse optional [AS] <alias> at startIndex; nil is returned if there is no alias
+func parseAlias(source []tokenItem, startIndex int) *SyntaxTree {
+	index := startIndex
+	if tokenAt(source, index).Type == TokenAs {
+		index++
+	}
+
+	item := tokenAt(source, index)
+	if item.Type != TokenLiteral || index == startIndex && isWord(item, "using") {
+		return nil
+	}
+
+	return &SyntaxTree{
+		ChildNodes:    []SyntaxTree{},
+		StartPosition: startIndex,
+		EndPosition:   index,
+		Source:        source,
+		DataType:      NodeAlias,
+	}
+}
+
+//parseJoins parse zero or more JOIN clauses after index; get appended nodes and index of last parsed token
+func parseJoins(source []tokenItem, index int, nodes []SyntaxTree) ([]SyntaxTree, int, error) {
+	for isJoinToken(tokenAt(source, index+1)) {
+		joinAST, err := parseJoin(source, index+1)
+		if err != nil {
+			return nil, index, err
+		}
+
+		nodes = append(nodes, *joinAST)
+		index = joinAST.EndPosition
+	}
+
+	return nodes, index, nil
+}
+
+func parseSet(source []tokenItem, startIndex int) (*SyntaxTree, error) {
+	//pattern:
+	//expr = SET <assignment>[, <assignment> ...]
+	//assignment = <column> = <expression>
+	//column = <literal>
+	//column = <literal>.<literal>
+	if tokenAt(source, startIndex).Type != TokenSet {
+		return nil, newExpectError(tokenAt(source, startIndex), "SET")
+	}
+
+	return parseAssignments(source, startIndex)
+}
+
+//parseAssignments parse assignment list which is led by keyword at startIndex; example: SET,
+//UPDATE of ON DUPLICATE KEY UPDATE
+func parseAssignments(source []tokenItem, startIndex int) (*SyntaxTree, error) {
+	nodes := []SyntaxTree{}
+	index := startIndex
+
+	for {
+		column, err := parseObjectName(source, index+1)
+		if err != nil {
+			return nil, err
+		}
+
+		if tokenAt(source, column.EndPosition+1).Type != TokenEqual {
+			return nil, newExpectError(tokenAt(source, column.EndPosition+1), "=")
+		}
+
+		value, err := parseExpresion(source, column.EndPosition+2)
+		if err != nil {
+			return nil, err
+		}
+
+		column.DataType = NodeColName
+		nodes = append(nodes, SyntaxTree{
+			ChildNodes:    []SyntaxTree{*column, *value},
+			StartPosition: column.StartPosition,
+			EndPosition:   value.EndPosition,
+			Source:        source,
+			DataType:      NodeAssignment,
+		})
+		index = value.EndPosition
+
+		if tokenAt(source, index+1).Type != TokenColon {
+			break
+		}
+		index++
+	}
+
+	return &SyntaxTree{
+		ChildNodes:    nodes,
+		StartPosition: startIndex,
+		EndPosition:   index,
+		Source:        source,
+		DataType:      NodeSet,
+	}, nil
+}
+
+func parseDelete(source []tokenItem, startIndex int) (*SyntaxTree, error) {
+	//pattern:
+	//expr = DELETE [<target>] <fromExpr> [<usingExpr>] [<joinExpr> ...] [<whereExpr>] [<orderbyExpr>] [<limitExpr>]
+	//target = <literal>
+	//target = <literal>.<literal>
+	if source[startIndex].Type != TokenDelete {
+		return nil, newExpectError(source[startIndex], "DELETE")
+	}
+
+	nodes := []SyntaxTree{}
+	index := startIndex
+
+	//MySQL and SQL Server delete target; example: DELETE a FROM invoice AS a JOIN ...
+	if tokenAt(source, index+1).Type == TokenLiteral {
+		target, err := parseObjectName(source, index+1)
+		if err != nil {
+			return nil, err
+		}
+
+		target.DataType = NodeIdentifier
+		nodes = append(nodes, *target)
+		index = target.EndPosition
+	}
+
+	if tokenAt(source, index+1).Type != TokenFrom {
+		return nil, newExpectError(tokenAt(source, index+1), "FROM")
+	}
+
+	from, err := parseFrom(source, index+1)
+	if err != nil {
+		return nil, err
+	}
+	nodes = append(nodes, *from)
+	index = from.EndPosition
+
+	if isWord(tokenAt(source, index+1), "using") {
+		using, err := parseUsing(source, index+1)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, *using)
+		index = using.EndPosition
+	}
+
+	nodes, index, err = parseJoins(source, index, nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseFilterClauses(source, startIndex, index, nodes, NodeDelete)
+}
+
+func parseUsing(source []tokenItem, startIndex int) (*SyntaxTree, error) {
+	//pattern:
+	//expr = USING <src> [[AS] <alias>]
+	//expr = USING (<selectExpr>) [AS] <alias>
+	//expr = USING (VALUES <rows>) [AS] <alias> [(<columns>)]
+	if !isWord(tokenAt(source, startIndex), "using") {
+		return nil, newExpectError(tokenAt(source, startIndex), "USING")
+	}
+
+	if tokenAt(source, startIndex+1).Type != TokenLeftParen || tokenAt(source, startIndex+2).Type != TokenValues {
+		return parseSourceClause(source, startIndex, NodeUsing)
+	}
+
+	paren, err := parseParenthesis(source, startIndex+1)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := parseValues(source, startIndex+2)
+	if err != nil {
+		return nil, err
+	}
+
+	if values.EndPosition+1 != paren.EndPosition {
+		return nil, newExpectError(source[values.EndPosition+1], ")")
+	}
+
+	alias := parseAlias(source, paren.EndPosition+1)
+	if alias == nil {
+		return nil, newExpectError(tokenAt(source, paren.EndPosition+1), "alias name")
+	}
+
+	nodes := []SyntaxTree{
+		SyntaxTree{
+			ChildNodes:    []SyntaxTree{*values},
+			StartPosition: paren.StartPosition,
+			EndPosition:   paren.EndPosition,
+			Source:        source,
+			DataType:      NodeSource,
+		},
+		*alias}
+	index := alias.EndPosition
+
+	if tokenAt(source, index+1).Type == TokenLeftParen {
+		columns, err := parseNameList(source, index+1)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, *columns)
+		index = columns.EndPosition
+	}
+
+	return &SyntaxTree{
+		ChildNodes:    nodes,
+		StartPosition: startIndex,
+		EndPosition:   index,
+		Source:        source,
+		DataType:      NodeUsing,
+	}, nil
+}
+
+func parseMerge(source []tokenItem, startIndex int) (*SyntaxTree, error) {
+	//pattern:
+	//expr = MERGE [INTO] <src> [[AS] <alias>] <usingExpr> ON <condition> <when> [<when> ...]
+	if !isWord(source[startIndex], "merge") {
+		return nil, newExpectError(source[startIndex], "MERGE")
+	}
+
+	index := startIndex + 1
+	if tokenAt(source, index).Type == TokenInto {
+		index++
+	}
+
+	table, err := parseObjectName(source, index)
+	if err != nil {
+		return nil, err
+	}
+	nodes := []SyntaxTree{*table}
+	index = table.EndPosition
+
+	if alias := parseAlias(source, index+1); alias != nil {
+		nodes = append(nodes, *alias)
+		index = alias.EndPosition
+	}
+
+	using, err := parseUsing(source, index+1)
+	if err != nil {
+		return nil, err
+	}
+
+	if tokenAt(source, using.EndPosition+1).Type != TokenOn {
+		return nil, newExpectError(tokenAt(source, using.EndPosition+1), "ON")
+	}
+
+	cond, err := parseCondition(source, using.EndPosition+2)
+	if err != nil {
+		return nil, err
+	}
+
+	//merge condition is kept in USING node as join condition
+	using.ChildNodes = append(using.ChildNodes, *cond)
+	using.EndPosition = cond.EndPosition
+	nodes = append(nodes, *using)
+	index = using.EndPosition
+
+	if !isWord(tokenAt(source, index+1), "when") {
+		return nil, newExpectError(tokenAt(source, index+1), "WHEN")
+	}
+
+	for isWord(tokenAt(source, index+1), "when") {
+		when, err := parseWhen(source, index+1)
+		if err != nil {
+			return nil, err
+		}
+
+		nodes = append(nodes, *when)
+		index = when.EndPosition
+	}
+
+	return &SyntaxTree{
+		ChildNodes:    nodes,
+		StartPosition: startIndex,
+		EndPosition:   index,
+		Source:        source,
+		DataType:      NodeMerge,
+	}, nil
+}
+
+func parseWhen(source []tokenItem, startIndex int) (*SyntaxTree, error) {
+	//pattern:
+	//expr = WHEN [NOT] MATCHED [AND <condition>] THEN <action>
+	//action = UPDATE <setExpr>
+	//action = DELETE
+	//action = INSERT [(<columns>)] VALUES <row>
+	index := startIndex + 1
+	if tokenAt(source, index).Type == TokenNot {
+		index++
+	}
+
+	if !isWord(tokenAt(source, index), "matched") {
+		return nil, newExpectError(tokenAt(source, index), "MATCHED")
+	}
+
+	nodes := []SyntaxTree{}
+	if tokenAt(source, index+1).Type == TokenAnd {
+		cond, err := parseCondition(source, index+2)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, *cond)
+		index = cond.EndPosition
+	}
+
+	if !isWord(tokenAt(source, index+1), "then") {
+		return nil, newExpectError(tokenAt(source, index+1), "THEN")
+	}
+	index += 2
+
+	action := SyntaxTree{
+		ChildNodes:    []SyntaxTree{},
+		StartPosition: index,
+		EndPosition:   index,
+		Source:        source,
+	}
+
+	switch tokenAt(source, index).Type {
+	case TokenUpdate:
+		set, err := parseSet(source, index+1)
+		if err != nil {
+			return nil, err
+		}
+		action = *set
+	case TokenDelete:
+		action.DataType = NodeDelete
+	case TokenInsert:
+		if tokenAt(source, action.EndPosition+1).Type == TokenLeftParen {
+			columns, err := parseNameList(source, action.EndPosition+1)
+			if err != nil {
+				return nil, err
+			}
+			action.ChildNodes = append(action.ChildNodes, *columns)
+			action.EndPosition = columns.EndPosition
+		}
+
+		if tokenAt(source, action.EndPosition+1).Type != TokenValues {
+			return nil, newExpectError(tokenAt(source, action.EndPosition+1), "VALUES")
+		}
+
+		values, err := parseValues(source, action.EndPosition+1)
+		if err != nil {
+			return nil, err
+		}
+		action.ChildNodes = append(action.ChildNodes, *values)
+		action.EndPosition = values.EndPosition
+		action.DataType = NodeInsert
+	default:
+		return nil, newExpectError(tokenAt(source, index), "UPDATE", "DELETE", "INSERT")
+	}
+
+	return &SyntaxTree{
+		ChildNodes:    append(nodes, action),
+		StartPosition: startIndex,
+		EndPosition:   action.EndPosition,
+		Source:        source,
+		DataType:      NodeWhen,
+	}, nil
+}
+
+//parseFilterClauses parse optional WHERE, ORDER BY and LIMIT clause of UPDATE / DELETE statement
+func parseFilterClauses(source []tokenItem, startIndex int, index int, nodes []SyntaxTree, nodeType NodeType) (*SyntaxTree, error) {
+	if tokenAt(source, index+1).Type == TokenWhere {
+		whereAST, err := parseWhere(source, index+1)
+		if err != nil {
+			return nil, err
+		}
+
+		nodes = append(nodes, *whereAST)
+		index = whereAST.EndPosition
+	}
+
+	if tokenAt(source, index+1).Type == TokenOrderBy {
+		orderbyAST, err := parseOrderBy(source, index+1)
+		if err != nil {
+			return nil, err
+		}
+
+		nodes = append(nodes, *orderbyAST)
+		index = orderbyAST.EndPosition
+	}
+
+	if tokenAt(source, index+1).Type == TokenLimit {
+		limitAST, err := parseLimit(source, index+1)
+		if err != nil {
+			return nil, err
+		}
+
+		nodes = append(nodes, *limitAST)
+		index = limitAST.EndPosition
+	}
+
+	return &SyntaxTree{
+		ChildNodes:    nodes,
+		StartPosition: startIndex,
+		EndPosition:   index,
+		Source:        source,
+		DataType:      nodeType,
+	}, nil
+}
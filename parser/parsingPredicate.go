@@ -0,0 +1,263 @@
+package parser
+
+func parsePredicate(source []tokenItem, startIndex int) (*SyntaxTree, error) {
+	//pattern:
+	//expr = (<condition>)
+	//expr = NOT <expr>
+	//expr = EXISTS (<selectExpr>)
+	//expr = <expression> [NOT] IN (<expression>[, <expression> ...])
+	//expr = <expression> [NOT] IN (<selectExpr>)
+	//expr = <expression> [NOT] BETWEEN <expression> AND <expression>
+	//expr = <expression> IS [NOT] NULL
+	//expr = <expression> [NOT] LIKE <expression>
+	//expr = <expression>
+	switch source[startIndex].Type {
+	case TokenLeftParen:
+		return parseSubCondition(source, startIndex)
+	case TokenNot:
+		predicate, err := parsePredicate(source, startIndex+1)
+		if err != nil {
+			return nil, err
+		}
+
+		return &SyntaxTree{
+			ChildNodes:    []SyntaxTree{*predicate},
+			StartPosition: startIndex,
+			EndPosition:   predicateEnd(source, startIndex+1, predicate),
+			Source:        source,
+			DataType:      NodeNot,
+		}, nil
+	case TokenExists:
+		query, err := parseSubQuery(source, startIndex+1)
+		if err != nil {
+			return nil, err
+		}
+
+		return &SyntaxTree{
+			ChildNodes:    []SyntaxTree{*query},
+			StartPosition: startIndex,
+			EndPosition:   query.EndPosition + 1,
+			Source:        source,
+			DataType:      NodeExists,
+		}, nil
+	}
+
+	expr, err := parseExpresion(source, startIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := []SyntaxTree{*expr}
+	index := expr.EndPosition + 1
+
+	negate := tokenAt(source, index).Type == TokenNot
+	if negate {
+		nodes = append(nodes, SyntaxTree{
+			ChildNodes:    []SyntaxTree{},
+			StartPosition: index,
+			EndPosition:   index,
+			Source:        source,
+			DataType:      NodeOperator,
+		})
+		index++
+	}
+
+	item := tokenAt(source, index)
+	switch {
+	case item.Type == TokenIn:
+		return parseIn(source, startIndex, index, nodes)
+	case item.Type == TokenBetween:
+		return parseBetween(source, startIndex, index, nodes)
+	case !negate && isWord(item, "is"):
+		return parseIsNull(source, startIndex, index, nodes)
+	case negate:
+		return nil, newExpectError(item, "IN", "BETWEEN", "LIKE")
+	}
+
+	return expr, nil
+}
+
+//predicateEnd get index of last token of predicate; parenthesized sub condition node
+//does not cover its parenthesis (nor parenthesis of trimmed inner sub condition), so it
+//is ended at matching close parenthesis
+func predicateEnd(source []tokenItem, startIndex int, predicate *SyntaxTree) int {
+	if source[startIndex].Type == TokenLeftParen {
+		if paren, err := parseParenthesis(source, startIndex); err == nil {
+			return paren.EndPosition
+		}
+	}
+
+	return predicate.EndPosition
+}
+
+//parseSubCondition parse parenthesized condition; single expression is not wrapped by condition node
+func parseSubCondition(source []tokenItem, startIndex int) (*SyntaxTree, error) {
+	paren, err := parseParenthesis(source, startIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	subCond, err := parseCondition(source, startIndex+1)
+	if err != nil {
+		return nil, err
+	}
+
+	if paren.EndPosition != subCond.EndPosition+1 {
+		return nil, newExpectError(source[subCond.EndPosition+1], ")")
+	}
+
+	//trim over-nested nodes
+	if len(subCond.ChildNodes) == 1 && subCond.ChildNodes[0].DataType == NodeExpression {
+		subCond = &subCond.ChildNodes[0]
+	}
+
+	return subCond, nil
+}
+
+//parseSubQuery parse parenthesized SELECT statement; returned node exclude parenthesis
+func parseSubQuery(source []tokenItem, startIndex int) (*SyntaxTree, error) {
+	if tokenAt(source, startIndex).Type != TokenLeftParen {
+		return nil, newExpectError(tokenAt(source, startIndex), "(")
+	}
+
+	paren, err := parseParenthesis(source, startIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := parseQuerySelect(source, startIndex+1)
+	if err != nil {
+		return nil, err
+	}
+
+	if query.EndPosition+1 != paren.EndPosition {
+		return nil, newExpectError(source[query.EndPosition+1], ")")
+	}
+
+	return query, nil
+}
+
+func parseIn(source []tokenItem, startIndex int, keywordIndex int, nodes []SyntaxTree) (*SyntaxTree, error) {
+	//pattern:
+	//expr = <expression> [NOT] IN (<expression>[, <expression> ...])
+	//expr = <expression> [NOT] IN (<selectExpr>)
+	if tokenAt(source, keywordIndex+2).Type == TokenSelect {
+		query, err := parseSubQuery(source, keywordIndex+1)
+		if err != nil {
+			return nil, err
+		}
+
+		return &SyntaxTree{
+			ChildNodes:    append(nodes, *query),
+			StartPosition: startIndex,
+			EndPosition:   query.EndPosition + 1,
+			Source:        source,
+			DataType:      NodeIn,
+		}, nil
+	}
+
+	list, err := parseExpressionList(source, keywordIndex+1)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SyntaxTree{
+		ChildNodes:    append(nodes, *list),
+		StartPosition: startIndex,
+		EndPosition:   list.EndPosition,
+		Source:        source,
+		DataType:      NodeIn,
+	}, nil
+}
+
+func parseBetween(source []tokenItem, startIndex int, keywordIndex int, nodes []SyntaxTree) (*SyntaxTree, error) {
+	//pattern:
+	//expr = <expression> [NOT] BETWEEN <expression> AND <expression>
+	lower, err := parseExpresion(source, keywordIndex+1)
+	if err != nil {
+		return nil, err
+	}
+
+	if tokenAt(source, lower.EndPosition+1).Type != TokenAnd {
+		return nil, newExpectError(tokenAt(source, lower.EndPosition+1), "AND")
+	}
+
+	upper, err := parseExpresion(source, lower.EndPosition+2)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SyntaxTree{
+		ChildNodes:    append(nodes, *lower, *upper),
+		StartPosition: startIndex,
+		EndPosition:   upper.EndPosition,
+		Source:        source,
+		DataType:      NodeBetween,
+	}, nil
+}
+
+func parseIsNull(source []tokenItem, startIndex int, keywordIndex int, nodes []SyntaxTree) (*SyntaxTree, error) {
+	//pattern:
+	//expr = <expression> IS [NOT] NULL
+	index := keywordIndex + 1
+	if tokenAt(source, index).Type == TokenNot {
+		nodes = append(nodes, SyntaxTree{
+			ChildNodes:    []SyntaxTree{},
+			StartPosition: index,
+			EndPosition:   index,
+			Source:        source,
+			DataType:      NodeOperator,
+		})
+		index++
+	}
+
+	if !isWord(tokenAt(source, index), "null") {
+		return nil, newExpectError(tokenAt(source, index), "NULL")
+	}
+
+	return &SyntaxTree{
+		ChildNodes:    nodes,
+		StartPosition: startIndex,
+		EndPosition:   index,
+		Source:        source,
+		DataType:      NodeIsNull,
+	}, nil
+}
+
+//parseExpressionList parse parenthesized non-empty expression list into NodeList
+func parseExpressionList(source []tokenItem, startIndex int) (*SyntaxTree, error) {
+	//pattern:
+	//expr = (<expression>[, <expression> ...])
+	if tokenAt(source, startIndex).Type != TokenLeftParen {
+		return nil, newExpectError(tokenAt(source, startIndex), "(")
+	}
+
+	paren, err := parseParenthesis(source, startIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := []SyntaxTree{}
+	for index := startIndex + 1; ; index++ {
+		expr, err := parseExpresion(source, index)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, *expr)
+		index = expr.EndPosition + 1
+
+		if index == paren.EndPosition {
+			break
+		} else if source[index].Type != TokenColon {
+			return nil, newExpectError(source[index], ",", ")")
+		}
+	}
+
+	return &SyntaxTree{
+		ChildNodes:    nodes,
+		StartPosition: paren.StartPosition,
+		EndPosition:   paren.EndPosition,
+		Source:        source,
+		DataType:      NodeList,
+	}, nil
+}
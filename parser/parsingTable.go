@@ -0,0 +1,619 @@
+package parser
+
+import (
+	"strings"
+)
+
+//dataTypeWords additional word(s) allowed in column data type
+var dataTypeWords = []string{"precision", "varying", "unsigned", "signed", "zerofill",
+	"with", "without", "time", "zone"}
+
+//isWord check token is unquoted literal which match any of the word (case insensitive);
+//DDL keywords are not reserved by lexer so that they still can be used as identifier
+func isWord(item tokenItem, words ...string) bool {
+	if item.Type != TokenLiteral {
+		return false
+	}
+
+	for _, word := range words {
+		if strings.EqualFold(item.Value, word) {
+			return true
+		}
+	}
+
+	return false
+}
+
+//tokenAt get token at index; EOF token is returned if index is out of range
+func tokenAt(source []tokenItem, index int) tokenItem {
+	if index < 0 || index >= len(source) {
+		//locate out of range token at end of source so error can be reported there
+		eof := tokenItem{TokenEOF, "", 0, 0}
+		if len(source) > 0 {
+			eof.Pos, eof.line = source[len(source)-1].Pos, source[len(source)-1].line
+		}
+		return eof
+	}
+
+	return source[index]
+}
+
+func parseCreate(source []tokenItem, startIndex int) (*SyntaxTree, error) {
+	//pattern:
+	//expr = CREATE [OR REPLACE] [TEMP|TEMPORARY] TABLE <createTable>
+	//expr = CREATE [OR REPLACE] [TEMP|TEMPORARY] VIEW <createView>
+	if source[startIndex].Type != TokenCreate {
+		return nil, newExpectError(source[startIndex], "CREATE")
+	}
+
+	index := startIndex + 1
+	options := []SyntaxTree{}
+	if tokenAt(source, index).Type == TokenOr && isWord(tokenAt(source, index+1), "replace") {
+		options = append(options, createOption(source, index, index+1))
+		index += 2
+	}
+
+	if isWord(tokenAt(source, index), "temp", "temporary") {
+		options = append(options, createOption(source, index, index))
+		index++
+	}
+
+	next := tokenAt(source, index)
+	switch next.Type {
+	case TokenTable:
+		return parseCreateTable(source, startIndex, index, options)
+	case TokenView:
+		return parseCreateView(source, startIndex, index, options)
+	default:
+		return nil, newExpectError(next, "TABLE", "VIEW")
+	}
+}
+
+//createOption create NodeCreateOption node covering tokens from start to end index
+func createOption(source []tokenItem, startIndex int, endIndex int) SyntaxTree {
+	return SyntaxTree{
+		ChildNodes:    []SyntaxTree{},
+		StartPosition: startIndex,
+		EndPosition:   endIndex,
+		Source:        source,
+		DataType:      NodeCreateOption,
+	}
+}
+
+//parseIfNotExists append IF NOT EXISTS option node if present; index after the option is returned
+func parseIfNotExists(source []tokenItem, startIndex int, options []SyntaxTree) ([]SyntaxTree, int) {
+	if isWord(tokenAt(source, startIndex), "if") &&
+		tokenAt(source, startIndex+1).Type == TokenNot &&
+		tokenAt(source, startIndex+2).Type == TokenExists {
+		return append(options, createOption(source, startIndex, startIndex+2)), startIndex + 3
+	}
+
+	return options, startIndex
+}
+
+func parseCreateTable(source []tokenItem, startIndex int, keywordIndex int,
+	options []SyntaxTree) (*SyntaxTree, error) {
+	//pattern:
+	//expr = CREATE TABLE [IF NOT EXISTS] <src> (<elements>) [<tableOptions>]
+	//src = <literal>
+	//src = <literal>.<literal>
+	//elements = <element>
+	//elements = <element>, <elements>
+	//element = <columnDefinition>
+	//element = <tableConstraint>
+	//tableOptions = <any token> ...; example: ENGINE=InnoDB DEFAULT CHARSET=utf8mb4
+	nodes, index := parseIfNotExists(source, keywordIndex+1, options)
+
+	name, err := parseObjectName(source, index)
+	if err != nil {
+		return nil, err
+	}
+	nodes = append(nodes, *name)
+	index = name.EndPosition + 1
+
+	if tokenAt(source, index).Type != TokenLeftParen {
+		return nil, newExpectError(tokenAt(source, index), "(")
+	}
+
+	paren, err := parseParenthesis(source, index)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		element, err := parseTableElement(source, index+1)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, *element)
+		index = element.EndPosition + 1
+
+		if index == paren.EndPosition {
+			break
+		} else if source[index].Type != TokenColon {
+			return nil, newExpectError(source[index], ",", ")")
+		}
+	}
+
+	endIndex := paren.EndPosition
+	for tokenAt(source, endIndex+1).Type != TokenSemiColon &&
+		tokenAt(source, endIndex+1).Type != TokenEOF {
+		endIndex++
+	}
+
+	if endIndex > paren.EndPosition {
+		nodes = append(nodes, SyntaxTree{
+			ChildNodes:    []SyntaxTree{},
+			StartPosition: paren.EndPosition + 1,
+			EndPosition:   endIndex,
+			Source:        source,
+			DataType:      NodeTableOption,
+		})
+	}
+
+	return &SyntaxTree{
+		ChildNodes:    nodes,
+		StartPosition: startIndex,
+		EndPosition:   endIndex,
+		Source:        source,
+		DataType:      NodeCreateTable,
+	}, nil
+}
+
+func parseObjectName(source []tokenItem, startIndex int) (*SyntaxTree, error) {
+	//pattern:
+	//expr = <literal>
+	//expr = <literal>.<literal>
+	item := tokenAt(source, startIndex)
+	if item.Type != TokenLiteral {
+		return nil, newExpectError(item, "name")
+	}
+
+	endIndex := startIndex
+	if tokenAt(source, endIndex+1).Type == TokenDot &&
+		tokenAt(source, endIndex+2).Type == TokenLiteral {
+		endIndex += 2
+	}
+
+	return &SyntaxTree{
+		ChildNodes:    []SyntaxTree{},
+		StartPosition: startIndex,
+		EndPosition:   endIndex,
+		Source:        source,
+		DataType:      NodeSource,
+	}, nil
+}
+
+func parseTableElement(source []tokenItem, startIndex int) (*SyntaxTree, error) {
+	//pattern:
+	//expr = <columnDefinition>
+	//expr = <tableConstraint>
+	item := tokenAt(source, startIndex)
+	next := tokenAt(source, startIndex+1)
+
+	switch {
+	case isWord(item, "constraint", "unique", "key", "index", "fulltext", "spatial"),
+		isWord(item, "primary", "foreign") && isWord(next, "key"):
+		return parseTableConstraint(source, startIndex)
+	case item.Type == TokenLiteral:
+		return parseColumnDefinition(source, startIndex)
+	default:
+		return nil, newExpectError(item, "column definition", "table constraint")
+	}
+}
+
+func parseColumnDefinition(source []tokenItem, startIndex int) (*SyntaxTree, error) {
+	//pattern:
+	//expr = <literal> <dataType>
+	//expr = <literal> <dataType> <columnOption> ...
+	nodes := []SyntaxTree{
+		SyntaxTree{
+			ChildNodes:    []SyntaxTree{},
+			StartPosition: startIndex,
+			EndPosition:   startIndex,
+			Source:        source,
+			DataType:      NodeColName,
+		}}
+
+	dataType, err := parseDataType(source, startIndex+1)
+	if err != nil {
+		return nil, err
+	}
+	nodes = append(nodes, *dataType)
+	index := dataType.EndPosition
+
+	for tokenAt(source, index+1).Type != TokenColon &&
+		tokenAt(source, index+1).Type != TokenRightParen {
+		option, err := parseColumnOption(source, index+1)
+		if err != nil {
+			return nil, err
+		}
+
+		nodes = append(nodes, *option)
+		index = option.EndPosition
+	}
+
+	return &SyntaxTree{
+		ChildNodes:    nodes,
+		StartPosition: startIndex,
+		EndPosition:   index,
+		Source:        source,
+		DataType:      NodeColumnDefinition,
+	}, nil
+}
+
+func parseDataType(source []tokenItem, startIndex int) (*SyntaxTree, error) {
+	//pattern:
+	//expr = <literal> [<word> ...] [(<param>[, <param> ...])] [<word> ...]
+	//param = <number>
+	//param = <string>
+	//example: INT(11) UNSIGNED, DECIMAL(10, 2), DOUBLE PRECISION, TIMESTAMP WITH TIME ZONE
+	item := tokenAt(source, startIndex)
+	if item.Type != TokenLiteral {
+		return nil, newExpectError(item, "data type")
+	}
+
+	index := startIndex
+	hasParam := false
+	for {
+		next := tokenAt(source, index+1)
+
+		if isWord(next, dataTypeWords...) {
+			index++
+			continue
+		} else if next.Type == TokenLeftParen && !hasParam {
+			paren, err := parseParenthesis(source, index+1)
+			if err != nil {
+				return nil, err
+			}
+
+			for i := index + 2; i < paren.EndPosition; i++ {
+				expectParam := (i-index)%2 == 0
+				if expectParam && source[i].Type != TokenNumber && source[i].Type != TokenString {
+					return nil, newExpectError(source[i], "number", "string")
+				} else if !expectParam && source[i].Type != TokenColon {
+					return nil, newExpectError(source[i], ",", ")")
+				}
+			}
+
+			hasParam = true
+			index = paren.EndPosition
+			continue
+		}
+
+		break
+	}
+
+	return &SyntaxTree{
+		ChildNodes:    []SyntaxTree{},
+		StartPosition: startIndex,
+		EndPosition:   index,
+		Source:        source,
+		DataType:      NodeDataType,
+	}, nil
+}
+
+func parseColumnOption(source []tokenItem, startIndex int) (*SyntaxTree, error) {
+	//pattern:
+	//expr = NOT NULL
+	//expr = NULL
+	//expr = AUTO_INCREMENT
+	//expr = AUTOINCREMENT
+	//expr = IDENTITY [(<number>, <number>)]
+	//expr = PRIMARY KEY [ASC|DESC]
+	//expr = UNIQUE [KEY]
+	//expr = DEFAULT <value>
+	//expr = ON UPDATE <value>
+	//expr = COMMENT <string>
+	//expr = COLLATE <literal>
+	//expr = CHARACTER SET <literal>
+	//expr = CHECK (<condition>)
+	//expr = <references>
+	item := tokenAt(source, startIndex)
+	next := tokenAt(source, startIndex+1)
+	endIndex := -1
+
+	switch {
+	case item.Type == TokenNot && isWord(next, "null"):
+		endIndex = startIndex + 1
+	case isWord(item, "null", "auto_increment", "autoincrement"):
+		endIndex = startIndex
+	case isWord(item, "identity"):
+		endIndex = startIndex
+		if next.Type == TokenLeftParen {
+			paren, err := parseParenthesis(source, startIndex+1)
+			if err != nil {
+				return nil, err
+			}
+			endIndex = paren.EndPosition
+		}
+	case isWord(item, "primary") && isWord(next, "key"):
+		endIndex = startIndex + 1
+		if tokenAt(source, endIndex+1).Type == TokenAsc || tokenAt(source, endIndex+1).Type == TokenDesc {
+			endIndex++
+		}
+	case isWord(item, "unique"):
+		endIndex = startIndex
+		if isWord(next, "key") {
+			endIndex++
+		}
+	case isWord(item, "default"):
+		value, err := parseDefaultValue(source, startIndex+1)
+		if err != nil {
+			return nil, err
+		}
+		endIndex = value.EndPosition
+	case item.Type == TokenOn && next.Type == TokenUpdate:
+		value, err := parseDefaultValue(source, startIndex+2)
+		if err != nil {
+			return nil, err
+		}
+		endIndex = value.EndPosition
+	case isWord(item, "comment", "collate", "charset") &&
+		(next.Type == TokenString || next.Type == TokenLiteral):
+		endIndex = startIndex + 1
+	case isWord(item, "character") && next.Type == TokenSet &&
+		tokenAt(source, startIndex+2).Type == TokenLiteral:
+		endIndex = startIndex + 2
+	case isWord(item, "check") && next.Type == TokenLeftParen:
+		paren, err := parseParenthesis(source, startIndex+1)
+		if err != nil {
+			return nil, err
+		}
+		endIndex = paren.EndPosition
+	case isWord(item, "references"):
+		return parseReferences(source, startIndex, []SyntaxTree{})
+	default:
+		return nil, newParseError(item, "unsupported column option %s", item.String())
+	}
+
+	return &SyntaxTree{
+		ChildNodes:    []SyntaxTree{},
+		StartPosition: startIndex,
+		EndPosition:   endIndex,
+		Source:        source,
+		DataType:      NodeColumnOption,
+	}, nil
+}
+
+func parseDefaultValue(source []tokenItem, startIndex int) (*SyntaxTree, error) {
+	//pattern:
+	//expr = [-]<number>
+	//expr = <string>
+	//expr = <literal>
+	//expr = <literal>(<any>)
+	//expr = (<any>)
+	item := tokenAt(source, startIndex)
+	endIndex := startIndex
+
+	switch {
+	case item.Type == TokenSubtract && tokenAt(source, startIndex+1).Type == TokenNumber:
+		endIndex = startIndex + 1
+	case item.Type == TokenNumber || item.Type == TokenString:
+		endIndex = startIndex
+	case item.Type == TokenLiteral:
+		if tokenAt(source, startIndex+1).Type == TokenLeftParen {
+			paren, err := parseParenthesis(source, startIndex+1)
+			if err != nil {
+				return nil, err
+			}
+			endIndex = paren.EndPosition
+		}
+	case item.Type == TokenLeftParen:
+		paren, err := parseParenthesis(source, startIndex)
+		if err != nil {
+			return nil, err
+		}
+		endIndex = paren.EndPosition
+	default:
+		return nil, newExpectError(item, "default value")
+	}
+
+	return &SyntaxTree{
+		ChildNodes:    []SyntaxTree{},
+		StartPosition: startIndex,
+		EndPosition:   endIndex,
+		Source:        source,
+		DataType:      NodeExpression,
+	}, nil
+}
+
+func parseTableConstraint(source []tokenItem, startIndex int) (*SyntaxTree, error) {
+	//pattern:
+	//expr = [CONSTRAINT [<name>]] PRIMARY KEY [<name>] (<keyColumns>) [<keyOptions>]
+	//expr = [CONSTRAINT [<name>]] UNIQUE [KEY|INDEX] [<name>] (<keyColumns>) [<keyOptions>]
+	//expr = [CONSTRAINT [<name>]] FOREIGN KEY [<name>] (<keyColumns>) <references>
+	//expr = KEY|INDEX [<name>] (<keyColumns>) [<keyOptions>]
+	//expr = FULLTEXT|SPATIAL [KEY|INDEX] [<name>] (<keyColumns>) [<keyOptions>]
+	//keyOptions = <any token> ...; example: USING BTREE
+	index := startIndex
+	nodes := []SyntaxTree{}
+
+	if isWord(source[index], "constraint") {
+		index++
+		if tokenAt(source, index).Type == TokenLiteral &&
+			!isWord(tokenAt(source, index), "primary", "unique", "foreign") {
+			nodes = append(nodes, SyntaxTree{
+				ChildNodes:    []SyntaxTree{},
+				StartPosition: index,
+				EndPosition:   index,
+				Source:        source,
+				DataType:      NodeIdentifier,
+			})
+			index++
+		}
+	}
+
+	var nodeType NodeType
+	item := tokenAt(source, index)
+	next := tokenAt(source, index+1)
+	switch {
+	case isWord(item, "primary") && isWord(next, "key"):
+		nodeType = NodePrimaryKey
+		index += 2
+	case isWord(item, "foreign") && isWord(next, "key"):
+		nodeType = NodeForeignKey
+		index += 2
+	case isWord(item, "unique"):
+		nodeType = NodeUniqueKey
+		index++
+		if isWord(next, "key", "index") {
+			index++
+		}
+	case index == startIndex && isWord(item, "key", "index"):
+		nodeType = NodeIndexKey
+		index++
+	case index == startIndex && isWord(item, "fulltext", "spatial"):
+		nodeType = NodeIndexKey
+		index++
+		if isWord(next, "key", "index") {
+			index++
+		}
+	default:
+		return nil, newExpectError(item, "PRIMARY KEY", "UNIQUE", "FOREIGN KEY")
+	}
+
+	//key name; constraint name is preferred if both are specified
+	if tokenAt(source, index).Type == TokenLiteral && tokenAt(source, index+1).Type == TokenLeftParen {
+		if len(nodes) == 0 {
+			nodes = append(nodes, SyntaxTree{
+				ChildNodes:    []SyntaxTree{},
+				StartPosition: index,
+				EndPosition:   index,
+				Source:        source,
+				DataType:      NodeIdentifier,
+			})
+		}
+		index++
+	}
+
+	columns, err := parseKeyColumns(source, index)
+	if err != nil {
+		return nil, err
+	}
+	nodes = append(nodes, *columns)
+	index = columns.EndPosition
+
+	if nodeType == NodeForeignKey {
+		if !isWord(tokenAt(source, index+1), "references") {
+			return nil, newExpectError(tokenAt(source, index+1), "REFERENCES")
+		}
+
+		references, err := parseReferences(source, index+1, nodes)
+		if err != nil {
+			return nil, err
+		}
+
+		references.StartPosition = startIndex
+		return references, nil
+	}
+
+	//key options
+	for tokenAt(source, index+1).Type != TokenColon &&
+		tokenAt(source, index+1).Type != TokenRightParen &&
+		tokenAt(source, index+1).Type != TokenEOF {
+		index++
+	}
+
+	return &SyntaxTree{
+		ChildNodes:    nodes,
+		StartPosition: startIndex,
+		EndPosition:   index,
+		Source:        source,
+		DataType:      nodeType,
+	}, nil
+}
+
+func parseKeyColumns(source []tokenItem, startIndex int) (*SyntaxTree, error) {
+	//pattern:
+	//expr = (<keyColumn>[, <keyColumn> ...])
+	//keyColumn = <literal> [(<number>)] [ASC|DESC]
+	if tokenAt(source, startIndex).Type != TokenLeftParen {
+		return nil, newExpectError(tokenAt(source, startIndex), "(")
+	}
+
+	paren, err := parseParenthesis(source, startIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := []SyntaxTree{}
+	index := startIndex + 1
+	for {
+		item := tokenAt(source, index)
+		if item.Type != TokenLiteral {
+			return nil, newExpectError(item, "column name")
+		}
+
+		endIndex := index
+		if tokenAt(source, endIndex+1).Type == TokenLeftParen &&
+			tokenAt(source, endIndex+2).Type == TokenNumber &&
+			tokenAt(source, endIndex+3).Type == TokenRightParen {
+			endIndex += 3
+		}
+
+		if tokenAt(source, endIndex+1).Type == TokenAsc || tokenAt(source, endIndex+1).Type == TokenDesc {
+			endIndex++
+		}
+
+		nodes = append(nodes, SyntaxTree{
+			ChildNodes:    []SyntaxTree{},
+			StartPosition: index,
+			EndPosition:   endIndex,
+			Source:        source,
+			DataType:      NodeColName,
+		})
+
+		if endIndex+1 == paren.EndPosition {
+			break
+		} else if source[endIndex+1].Type != TokenColon {
+			return nil, newExpectError(source[endIndex+1], ",", ")")
+		}
+
+		index = endIndex + 2
+	}
+
+	return &SyntaxTree{
+		ChildNodes:    nodes,
+		StartPosition: startIndex,
+		EndPosition:   paren.EndPosition,
+		Source:        source,
+		DataType:      NodeList,
+	}, nil
+}
+
+func parseReferences(source []tokenItem, startIndex int, nodes []SyntaxTree) (*SyntaxTree, error) {
+	//pattern:
+	//expr = REFERENCES <src> (<keyColumns>) [ON DELETE <action>] [ON UPDATE <action>]
+	//action = CASCADE | RESTRICT | SET NULL | SET DEFAULT | NO ACTION
+	refTable, err := parseObjectName(source, startIndex+1)
+	if err != nil {
+		return nil, err
+	}
+	nodes = append(nodes, *refTable)
+
+	refColumns, err := parseKeyColumns(source, refTable.EndPosition+1)
+	if err != nil {
+		return nil, err
+	}
+	nodes = append(nodes, *refColumns)
+	index := refColumns.EndPosition
+
+	for tokenAt(source, index+1).Type == TokenOn &&
+		(tokenAt(source, index+2).Type == TokenDelete || tokenAt(source, index+2).Type == TokenUpdate) {
+		index += 2
+
+		for tokenAt(source, index+1).Type == TokenSet ||
+			isWord(tokenAt(source, index+1), "cascade", "restrict", "null", "default", "no", "action") {
+			index++
+		}
+	}
+
+	return &SyntaxTree{
+		ChildNodes:    nodes,
+		StartPosition: startIndex,
+		EndPosition:   index,
+		Source:        source,
+		DataType:      NodeForeignKey,
+	}, nil
+}
@@ -0,0 +1,91 @@
+package parser
+
+func parseCreateView(source []tokenItem, startIndex int, keywordIndex int,
+	options []SyntaxTree) (*SyntaxTree, error) {
+	//pattern:
+	//expr = CREATE VIEW [IF NOT EXISTS] <src> [(<columns>)] AS <query>
+	//src = <literal>
+	//src = <literal>.<literal>
+	//columns = <literal>
+	//columns = <literal>, <columns>
+	nodes, index := parseIfNotExists(source, keywordIndex+1, options)
+
+	name, err := parseObjectName(source, index)
+	if err != nil {
+		return nil, err
+	}
+	nodes = append(nodes, *name)
+	index = name.EndPosition + 1
+
+	if tokenAt(source, index).Type == TokenLeftParen {
+		columns, err := parseNameList(source, index)
+		if err != nil {
+			return nil, err
+		}
+
+		nodes = append(nodes, *columns)
+		index = columns.EndPosition + 1
+	}
+
+	if tokenAt(source, index).Type != TokenAs {
+		return nil, newExpectError(tokenAt(source, index), "AS")
+	}
+
+	if tokenAt(source, index+1).Type != TokenSelect {
+		return nil, newExpectError(tokenAt(source, index+1), "SELECT")
+	}
+
+	query, err := parseQuery(source, index+1)
+	if err != nil {
+		return nil, err
+	}
+	nodes = append(nodes, *query)
+
+	return &SyntaxTree{
+		ChildNodes:    nodes,
+		StartPosition: startIndex,
+		EndPosition:   query.EndPosition,
+		Source:        source,
+		DataType:      NodeCreateView,
+	}, nil
+}
+
+func parseNameList(source []tokenItem, startIndex int) (*SyntaxTree, error) {
+	//pattern:
+	//expr = (<literal>[, <literal> ...])
+	paren, err := parseParenthesis(source, startIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := []SyntaxTree{}
+	for index := startIndex + 1; index < paren.EndPosition; index += 2 {
+		if source[index].Type != TokenLiteral {
+			return nil, newExpectError(source[index], "column name")
+		}
+
+		if index+1 != paren.EndPosition && source[index+1].Type != TokenColon {
+			return nil, newExpectError(source[index+1], ",", ")")
+		}
+
+		nodes = append(nodes, SyntaxTree{
+			ChildNodes:    []SyntaxTree{},
+			StartPosition: index,
+			EndPosition:   index,
+			Source:        source,
+			DataType:      NodeColName,
+		})
+	}
+
+	if len(nodes) == 0 {
+		return nil, newExpectError(source[paren.EndPosition], "column name")
+	}
+
+	return &SyntaxTree{
+		ChildNodes:    nodes,
+		StartPosition: startIndex,
+		EndPosition:   paren.EndPosition,
+		Source:        source,
+		DataType:      NodeList,
+	}, nil
+}
@@ -2,6 +2,7 @@ package parser
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 )
 
@@ -191,11 +192,21 @@ func Test_parseFunction(t *testing.T) {
 		t.Errorf("expect syntax error since incomplete function's parenthesis")
 	}
 
-	token = tokenize("SUM(a.b, gg)")
+	token = tokenize("SUM(a.b gg)")
 	if _, err := parseFunction(token, 0); err == nil {
 		t.Errorf("expect syntax error since expression need to ended side to right parenthesis")
 	}
 
+	token = tokenize("COALESCE(a.b, )")
+	if _, err := parseFunction(token, 0); err == nil {
+		t.Errorf("expect syntax error since argument is missing after comma")
+	}
+
+	token = tokenize("COUNT(DISTINCT)")
+	if _, err := parseFunction(token, 0); err == nil {
+		t.Errorf("expect syntax error since argument is missing after DISTINCT")
+	}
+
 	token = tokenize("3 + MAX")
 	if _, err := parseFunction(token, 2); err == nil {
 		t.Errorf("expect syntax error since it is incomplete syntax")
@@ -207,6 +218,50 @@ func Test_parseFunction(t *testing.T) {
 	}
 }
 
+func Test_parseFunction_arguments(t *testing.T) {
+	sqls := map[string][]NodeType{
+		"NOW()":                       []NodeType{NodeIdentifier},
+		"COUNT(*)":                    []NodeType{NodeIdentifier, NodeExpression},
+		"count ( DISTINCT a.id )":     []NodeType{NodeIdentifier, NodeDistinct, NodeExpression},
+		"COALESCE(MAX(a.x), b.y, 0)":  []NodeType{NodeIdentifier, NodeExpression, NodeExpression, NodeExpression},
+		"my_fn(1 + (2 * a), 'x', :p)": []NodeType{NodeIdentifier, NodeExpression, NodeExpression, NodeExpression}}
+
+	for sql, expectedTypes := range sqls {
+		ast, err := parseFunction(tokenize(sql), 0)
+		if err != nil {
+			t.Errorf("%s: %s", sql, err.Error())
+			continue
+		}
+
+		if ast.EndPosition != len(ast.Source)-2 {
+			t.Errorf("Expect function ended at last token but get %d: %s", ast.EndPosition, sql)
+		}
+
+		if len(ast.ChildNodes) != len(expectedTypes) {
+			t.Errorf("Expect %d nodes but get %d: %s", len(expectedTypes), len(ast.ChildNodes), sql)
+			continue
+		}
+
+		for index, nodeType := range expectedTypes {
+			if ast.ChildNodes[index].DataType != nodeType {
+				t.Errorf("Expect node %d is node type %d but get %d: %s",
+					index, nodeType, ast.ChildNodes[index].DataType, sql)
+			}
+		}
+	}
+
+	//nested function is operand of argument expression
+	ast, err := parseFunction(tokenize("COALESCE(MAX(a.x), 0)"), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if nested := ast.ChildNodes[1].ChildNodes[0]; nested.DataType != NodeFunction ||
+		strings.Compare(nested.Text(), "MAX(a.x)") != 0 {
+		t.Errorf("Expect nested function MAX(a.x) but get %s (%d)", nested.Text(), nested.DataType)
+	}
+}
+
 func Test_parseCondition(t *testing.T) {
 
 	token := tokenize("a.b > 45 AND 5 != 3")
@@ -261,6 +316,77 @@ func Test_parseCondition(t *testing.T) {
 	}
 }
 
+func Test_parsePredicate(t *testing.T) {
+	sqls := map[string][]NodeType{
+		"a.id IN (1, 2, :p)":                             []NodeType{NodeExpression, NodeList},
+		"a.id NOT IN (SELECT id FROM b WHERE c > 1)":     []NodeType{NodeExpression, NodeOperator, NodeQuerySelect},
+		"a.amount BETWEEN 10 AND b.max - 1":              []NodeType{NodeExpression, NodeExpression, NodeExpression},
+		"a.amount NOT BETWEEN -1 AND 1":                  []NodeType{NodeExpression, NodeOperator, NodeExpression, NodeExpression},
+		"a.deleted_on IS NULL":                           []NodeType{NodeExpression},
+		"COALESCE(a.x, b.x) IS NOT NULL":                 []NodeType{NodeExpression, NodeOperator},
+		"NOT (a.x = 1 OR a.y = 2)":                       []NodeType{NodeCondition},
+		"a.name NOT LIKE 'x%'":                           []NodeType{NodeOperand, NodeOperator, NodeOperand},
+		"NOT a.name LIKE :p":                             []NodeType{NodeExpression},
+		"NOT EXISTS (SELECT 1 FROM b WHERE b.id = a.id)": []NodeType{NodeExists}}
+
+	for sql, expectedTypes := range sqls {
+		source := tokenize(sql)
+		ast, err := parsePredicate(source, 0)
+		if err != nil {
+			t.Errorf("%s: %s", sql, err.Error())
+			continue
+		}
+
+		if predicateEnd(source, 0, ast) != len(source)-2 {
+			t.Errorf("Expect predicate ended at last token but get %d: %s", ast.EndPosition, sql)
+		}
+
+		if len(ast.ChildNodes) != len(expectedTypes) {
+			t.Errorf("Expect %d nodes but get %d: %s", len(expectedTypes), len(ast.ChildNodes), sql)
+			continue
+		}
+
+		for index, nodeType := range expectedTypes {
+			if ast.ChildNodes[index].DataType != nodeType {
+				t.Errorf("Expect node %d is node type %d but get %d: %s",
+					index, nodeType, ast.ChildNodes[index].DataType, sql)
+			}
+		}
+	}
+
+	cond, err := parseCondition(tokenize("a IN (1) AND b BETWEEN 1 AND 2 OR NOT c IS NULL AND EXISTS (SELECT x FROM y)"), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedTypes := []NodeType{NodeIn, NodeOperator, NodeBetween, NodeOperator, NodeNot, NodeOperator, NodeExists}
+	if len(cond.ChildNodes) != len(expectedTypes) {
+		t.Fatalf("Expect %d nodes but get %d", len(expectedTypes), len(cond.ChildNodes))
+	}
+	for index, nodeType := range expectedTypes {
+		if cond.ChildNodes[index].DataType != nodeType {
+			t.Errorf("Expect node %d is node type %d but get %d", index, nodeType, cond.ChildNodes[index].DataType)
+		}
+	}
+
+	invalidSQLs := []string{
+		"a IN ()",
+		"a IN (1,)",
+		"a IN 1",
+		"a IN (SELECT b FROM c",
+		"a BETWEEN 1",
+		"a BETWEEN 1 OR 2",
+		"a IS NOT 1",
+		"EXISTS SELECT b FROM c",
+		"NOT (a = 1"}
+
+	for _, sql := range invalidSQLs {
+		if _, err := parsePredicate(tokenize(sql), 0); err == nil {
+			t.Errorf("Expect error for: %s", sql)
+		}
+	}
+}
+
 func Test_parseJoin(t *testing.T) {
 	token := tokenize("JOIN student AS stu ON a.name = stu.name AND a.age = stu.age")
 	if _, err := parseJoin(token, 0); err != nil {
@@ -361,6 +487,13 @@ func Test_parseSelect(t *testing.T) {
 		fmt.Println(tmpLog)
 	}
 
+	ast, err = parseSelect(tokenize("SELECT DISTINCT a, b"), 0)
+	if err != nil {
+		t.Error(err)
+	} else if len(ast.ChildNodes) != 3 || ast.ChildNodes[0].DataType != NodeDistinct {
+		t.Errorf("expect parsing SELECT DISTINCT return DISTINCT node and 2 columns but get %d nodes", len(ast.ChildNodes))
+	}
+
 	token = tokenize("JOIN student AS stu ON a.name = stu.name AND a.age = stu.age")
 	if _, err := parseSelect(token, 0); err == nil {
 		t.Errorf("expect synxtax error")
@@ -545,6 +678,46 @@ func Test_parseOrderBy(t *testing.T) {
 	}
 }
 
+func Test_parseOrderByExpression(t *testing.T) {
+	token := tokenize("GROUP BY LOWER(a.name), YEAR (a.created_on) DESC")
+	gb, err := parseGroupBy(token, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(gb.ChildNodes) != 2 || gb.ChildNodes[0].ChildNodes[0].DataType != NodeFunction ||
+		len(gb.ChildNodes[1].ChildNodes) != 2 {
+		t.Errorf("expect parse result gives 2 function columns, second one with order")
+	}
+
+	token = tokenize("ORDER BY COUNT(*) DESC, a.qty * a.price, b ASC")
+	ob, err := parseOrderBy(token, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ob.ChildNodes) != 3 {
+		t.Fatalf("expect parse result gives 3 columns but get %d instead", len(ob.ChildNodes))
+	}
+
+	expectedTypes := []NodeType{NodeFunction, NodeExpression, NodeColName}
+	for index, nodeType := range expectedTypes {
+		if ob.ChildNodes[index].ChildNodes[0].DataType != nodeType {
+			t.Errorf("expect column %d is node type %d but get %d",
+				index+1, nodeType, ob.ChildNodes[index].ChildNodes[0].DataType)
+		}
+	}
+
+	if len(ob.ChildNodes[0].ChildNodes) != 2 || len(ob.ChildNodes[2].ChildNodes) != 2 {
+		t.Errorf("expect column 1 and 3 gives 2 nodes (col, order)")
+	}
+
+	invalidSQLs := []string{"ORDER BY COUNT(", "ORDER BY DESC", "ORDER BY a +"}
+	for _, sql := range invalidSQLs {
+		if _, err := parseOrderBy(tokenize(sql), 0); err == nil {
+			t.Errorf("Expect syntax error for: %s", sql)
+		}
+	}
+}
+
 func Test_parseHaving(t *testing.T) {
 	token := tokenize("HAVING COUNT(student) > 3")
 	if _, err := parseHaving(token, 0); err != nil {